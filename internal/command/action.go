@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -12,12 +13,9 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-//go:embed templates/readme.gotxt
+//go:embed templates/readme/readme.gotxt
 var templateREADME string
 
-//go:embed templates/coc.gotxt
-var templateCOC string
-
 //go:embed templates/license/mit.gotxt
 var templateLicenseMIT string
 
@@ -45,7 +43,7 @@ var templateLicenseBSL10 string
 //go:embed templates/license/the-unlicense.gotxt
 var templateLicenseTHEUNL string
 
-//go:embed templates/bumpversion.txt
+//go:embed templates/bumpversion.gotxt
 var templateBumpVersion string
 
 type (
@@ -70,20 +68,40 @@ type (
 
 	readmeVariables struct {
 		FullName               string
+		Email                  string
 		GitHubUsername         string
 		ProjectName            string
 		RepositoryName         string
+		Version                string
+		Versioning             string
 		License                string
 		LicenseDescription     string
+		LicenseFiles           []licenseFile
 		AddLicense             bool
 		AddForkInfo            bool
 		AddCOC                 bool
 		AddBumpVersion         bool
+		AddChangelog           bool
+		AddContributing        bool
+		AddSupport             bool
 		AddCodeowners          bool
 		AddFunding             bool
 		AddPullRequestTemplate bool
 		AddIssueTemplate       bool
 		AddSecurity            bool
+		Badges                 []string
+		Sections               []renderedReadmeSection
+		Text                   readmeTexts
+		Languages              []readmeLanguageLink
+		COCFileName            string
+		Go                     goVariables
+		Python                 pythonVariables
+		Rust                   rustVariables
+		Node                   nodeVariables
+		Docker                 dockerVariables
+		Action                 actionVariables
+		Terraform              terraformVariables
+		Helm                   helmVariables
 	}
 	projectStyle  string
 	projectStyles map[projectStyle]string
@@ -107,15 +125,16 @@ const (
 	licenseAPACHE20         = licenseType("apache-20")
 	licenseBSL10            = licenseType("bsl-10")
 	licenseTHEUNL           = licenseType("unli")
+	licenseMITApache20      = licenseType("mit-apache-20")
 
 	projectStyleGo = projectStyle("go")
 
-	fnReadme      = "README.md"
-	fnCOC         = "CODE_OF_CONDUCT.md"
-	fnLicense     = "LICENSE"
-	fnBumpVersion = ".bumpversion.toml"
-
-	// fnIssueTemplateFeatureRequest = "feature_request.md".
+	fnReadme        = "README.md"
+	fnCOC           = "CODE_OF_CONDUCT.md"
+	fnLicense       = "LICENSE"
+	fnLicenseMIT    = "LICENSE-MIT"
+	fnLicenseApache = "LICENSE-APACHE"
+	fnBumpVersion   = ".bumpversion.toml"
 )
 
 // sentinel errors.
@@ -137,14 +156,37 @@ func availableLicenseTypes() licenseTypes {
 		licenseAPACHE20:         "Apache License 2.0",
 		licenseBSL10:            "Boost Software License 1.0",
 		licenseTHEUNL:           "The Unlicense",
+		licenseMITApache20:      "MIT OR Apache-2.0 dual license",
 	}
 }
 
 func availableProjectStyles() projectStyles {
 	return projectStyles{
-		projectStyleGo: `creates .github/workflows/, linter and tester actions, 
-            golangci.yml, .pre-commit-config.yaml, dependabot.yml, .gitignore
-            .pre-commit-config.yaml, .codecov.yml`,
+		projectStyleGo: `creates go.mod, cli, library or service layout (--go-layout),
+            .github/workflows/, linter and tester actions, .golangci.yml,
+            .pre-commit-config.yaml, dependabot.yml, .gitignore, .codecov.yml`,
+		projectStyleRust: `creates Cargo.toml (MIT OR Apache-2.0 by default), src/main.rs or src/lib.rs
+            (--rust-crate-type), rustfmt.toml, clippy.toml, deny.toml, .github/workflows/,
+            fmt, clippy, test and deny action, dependabot.yml, .gitignore`,
+		projectStyleNode: `creates package.json, src/index.js or src/index.ts with tsconfig.json (--ts),
+            eslint.config.js, .prettierrc.json, .nvmrc, .github/workflows/, lint, test and
+            npm publish actions, dependabot.yml, .gitignore`,
+		projectStyleTerraform: `creates registry compatible module for terraform-<PROVIDER>-<NAME>
+            repositories, main.tf, variables.tf, outputs.tf, versions.tf, examples/basic,
+            .tflint.hcl, .terraform-docs.yml, .github/workflows/, fmt, validate, tflint and
+            docs actions, .pre-commit-config.yaml, dependabot.yml, .gitignore`,
+		projectStyleHelm: `creates charts/<REPO>/ with Chart.yaml, values.yaml, values.schema.json,
+            deployment, service and test templates, ct.yaml, .github/workflows/,
+            chart-testing lint and chart-releaser actions publishing to GitHub Pages`,
+		projectStyleAction: `creates action.yml with branding, composite, docker or node action
+            (--action-type), README usage, .github/workflows/, self-test action and
+            release action moving major version tag`,
+		projectStyleDocker: `creates multi-stage Dockerfile of the language style it is layered on
+            (distroless for go), .dockerignore, compose.yaml, .github/workflows/,
+            multi-arch GHCR publish action with OCI labels, dependabot.yml`,
+		projectStylePython: `creates pyproject.toml, src/ package layout with pytest, ruff.toml,
+            .github/workflows/, matrix tester and linter actions,
+            .pre-commit-config.yaml, dependabot.yml, .gitignore, .codecov.yml`,
 	}
 }
 
@@ -206,7 +248,16 @@ func (k *cmd) actions() func(*cli.Context) error {
 			return ErrRepositoryNameRequired
 		}
 
+		argProjectStyles, err := parseProjectStyles(c.StringSlice("project-style"))
+		if err != nil {
+			return err
+		}
+		styleDefinition := argProjectStyles.definition()
+
 		argLicense := c.String("license")
+		if !c.IsSet("license") && styleDefinition.DefaultLicense != "" {
+			argLicense = styleDefinition.DefaultLicense.String()
+		}
 		argNoLicense := c.Bool("disable-license")
 		if !argNoLicense {
 			licenseAsType := licenseType(argLicense)
@@ -225,6 +276,267 @@ func (k *cmd) actions() func(*cli.Context) error {
 			}
 		}
 
+		argProfile, err := loadProfile(c.String("profile"))
+		if err != nil {
+			return fmt.Errorf("could not load profile, %w", err)
+		}
+
+		var goVars goVariables
+		if argProjectStyles.has(projectStyleGo) {
+			if goVars, err = goStyleVariables(c, argProfile, argProjectStyles.root(projectStyleGo)); err != nil {
+				return fmt.Errorf("could not load go style, %w", err)
+			}
+			styleDefinition.VersionFiles = append(styleDefinition.VersionFiles, goVars.bumpVersionFiles()...)
+		}
+
+		var rustVars rustVariables
+		if argProjectStyles.has(projectStyleRust) {
+			licenseForRust := licenseType(argLicense)
+			if argNoLicense {
+				licenseForRust = ""
+			}
+			rustVars, err = newRustVariables(
+				c.String("rust-crate-type"),
+				c.String("repository-name"),
+				argProjectStyles.root(projectStyleRust),
+				licenseForRust,
+			)
+			if err != nil {
+				return fmt.Errorf("could not load rust style, %w", err)
+			}
+		}
+
+		var dockerVars dockerVariables
+		if argProjectStyles.has(projectStyleDocker) {
+			dockerVars, err = newDockerVariables(
+				argProjectStyles,
+				c.String("username"),
+				argRepositoryName,
+				goVars,
+				rustVars,
+			)
+			if err != nil {
+				return fmt.Errorf("could not load docker style, %w", err)
+			}
+		}
+
+		var terraformVars terraformVariables
+		if argProjectStyles.has(projectStyleTerraform) {
+			terraformVars, err = newTerraformVariables(argRepositoryName, argProjectStyles.root(projectStyleTerraform))
+			if err != nil {
+				return fmt.Errorf("could not load terraform style, %w", err)
+			}
+		}
+
+		var helmVars helmVariables
+		if argProjectStyles.has(projectStyleHelm) {
+			helmVars = newHelmVariables(c.String("username"), argRepositoryName, argProjectStyles.root(projectStyleHelm))
+			styleDefinition.VersionFiles = append(styleDefinition.VersionFiles, helmVars.bumpVersionFiles()...)
+		}
+
+		var actionVars actionVariables
+		if argProjectStyles.has(projectStyleAction) {
+			actionVars, err = newActionVariables(c.String("action-type"), argRepositoryName, argProjectStyles)
+			if err != nil {
+				return fmt.Errorf("could not load action style, %w", err)
+			}
+		}
+
+		argBadges := c.StringSlice("badge")
+		if !c.IsSet("badge") {
+			argBadges = argProfile.Badges
+		}
+		argDisabledBadges := c.StringSlice("disable-badge")
+		if !c.IsSet("disable-badge") {
+			argDisabledBadges = argProfile.DisableBadges
+		}
+
+		if err = validateBadges(argBadges, argDisabledBadges); err != nil {
+			return fmt.Errorf("could not select badges, %w", err)
+		}
+
+		readmeSections := mergeReadmeSections(
+			defaultReadmeSections(),
+			styleDefinition.ReadmeSections,
+			argProfile.ReadmeSections,
+		)
+		if err = validateReadmeSections(readmeSections); err != nil {
+			return fmt.Errorf("could not load readme sections, %w", err)
+		}
+
+		argVersioning := versioningScheme(c.String("versioning"))
+		versioning, ok := versioningDefinitions()[argVersioning]
+		if !ok {
+			vkeys := make([]string, 0, len(availableVersioningSchemes()))
+			for k := range availableVersioningSchemes() {
+				vkeys = append(vkeys, "`"+string(k)+"`")
+			}
+			sort.Strings(vkeys)
+
+			return fmt.Errorf(
+				"%w `%s`. valid versioning arguments are: %s",
+				ErrInvalidVersioning,
+				argVersioning,
+				strings.Join(vkeys, ", "),
+			)
+		}
+		if versioning.RequiresStyle != "" && !argProjectStyles.has(versioning.RequiresStyle) {
+			return fmt.Errorf("%w `%s`: %s", ErrVersioningRequiresStyle, versioning.RequiresStyle, argVersioning)
+		}
+		if versioning.StyleAtRoot && argProjectStyles.root(versioning.RequiresStyle) != "" {
+			return fmt.Errorf(
+				"%w `%s` at repository root: %s",
+				ErrVersioningRequiresStyle,
+				versioning.RequiresStyle,
+				argVersioning,
+			)
+		}
+
+		argInitialVersion := c.String("initial-version")
+		if !c.IsSet("initial-version") {
+			argInitialVersion = argVersioning.defaultVersion(time.Now())
+		}
+		if !versioning.VersionFormat.MatchString(argInitialVersion) {
+			return fmt.Errorf("%w `%s`: %s", ErrInvalidVersion, argVersioning, argInitialVersion)
+		}
+
+		argCOC := cocType(c.String("coc"))
+		argCOCFile := c.String("coc-file")
+		if argCOCFile != "" {
+			argCOC = cocCustom
+		}
+		if !c.Bool("disable-coc") {
+			if _, ok := availableCOCTypes()[argCOC]; !ok && argCOC != cocCustom {
+				ckeys := make([]string, 0, len(availableCOCTypes()))
+				for k := range availableCOCTypes() {
+					ckeys = append(ckeys, "`"+string(k)+"`")
+				}
+				sort.Strings(ckeys)
+
+				return fmt.Errorf(
+					"%w `%s`. valid coc arguments are: %s",
+					ErrInvalidCOC,
+					argCOC,
+					strings.Join(ckeys, ", "),
+				)
+			}
+			if argCOC == cocCustom && argCOCFile == "" {
+				return ErrCOCFileRequired
+			}
+		}
+
+		argAgreement := contributorAgreement(c.String("contributor-agreement"))
+		argCLAURL := c.String("cla-url")
+		if _, ok := availableContributorAgreements()[argAgreement]; !ok {
+			return fmt.Errorf("%w `%s`", ErrInvalidContributorAgreement, argAgreement)
+		}
+		if argAgreement == contributorAgreementCLA && argCLAURL == "" && !c.Bool("disable-contributing") {
+			return ErrCLAURLRequired
+		}
+
+		codeownersRules := argProfile.Codeowners
+		if len(codeownersRules) == 0 && c.String("username") != "" {
+			codeownersRules = []codeownersRule{{Pattern: "*", Owners: []string{"@" + c.String("username")}}}
+		}
+		if err = validateCodeownersRules(codeownersRules); err != nil {
+			return fmt.Errorf("could not load codeowners, %w", err)
+		}
+
+		argFunding := argProfile.Funding
+		if c.IsSet("funding") {
+			if argFunding, err = parseFundingFlags(c.StringSlice("funding")); err != nil {
+				return fmt.Errorf("could not load funding, %w", err)
+			}
+		}
+		fundingVars := fundingVariables{}
+		if fundingVars.Entries, err = fundingEntries(argFunding); err != nil {
+			return fmt.Errorf("could not load funding, %w", err)
+		}
+
+		argWebsite := strings.TrimSuffix(c.String("website"), "/")
+		if argWebsite != "" && !validHTTPURL(argWebsite) {
+			return fmt.Errorf("%w `%s`", ErrInvalidWebsite, argWebsite)
+		}
+
+		argORCID := c.String("orcid")
+		if !c.IsSet("orcid") {
+			argORCID = argProfile.ORCID
+		}
+		if argORCID != "" {
+			if argORCID, err = normalizeORCID(argORCID); err != nil {
+				return fmt.Errorf("could not load citation, %w", err)
+			}
+		}
+		argAffiliation := c.String("affiliation")
+		if !c.IsSet("affiliation") {
+			argAffiliation = argProfile.Affiliation
+		}
+		argCitation := c.Bool("citation")
+		argCodemeta := c.Bool("codemeta")
+		if (argCitation || argCodemeta) && strings.TrimSpace(c.String("full-name")) == "" {
+			return ErrCitationAuthorRequired
+		}
+
+		argSecurityContacts := c.StringSlice("security-contact")
+		if !c.IsSet("security-contact") && reEmail.MatchString(c.String("email")) {
+			argSecurityContacts = []string{c.String("email")}
+		}
+
+		securityVars := securityVariables{
+			Website:          argWebsite,
+			PrivateReporting: !c.Bool("disable-private-reporting") && c.String("username") != "",
+			AcknowledgeDays:  securityAcknowledgeDays,
+			AssessmentDays:   securityAssessmentDays,
+			DisclosureDays:   securityDisclosureDays,
+			Expires:          securityTXTExpires(time.Now()),
+		}
+		if securityVars.Contacts, err = securityContacts(argSecurityContacts); err != nil {
+			return fmt.Errorf("could not load security contacts, %w", err)
+		}
+		if argPGPKey := c.String("security-pgp-key"); argPGPKey != "" {
+			securityVars.PGPPublicKey, securityVars.PGPFingerprint, err = readPGPPublicKey(argPGPKey)
+			if err != nil {
+				return fmt.Errorf("could not load pgp key, %w", err)
+			}
+			securityVars.PGPEncryptionURI = "openpgp4fpr:" + compactFingerprint(securityVars.PGPFingerprint)
+		}
+
+		argPullRequestTemplates := c.StringSlice("pull-request-template")
+		if !c.IsSet("pull-request-template") {
+			argPullRequestTemplates = argProfile.PullRequestTemplates
+		}
+		if err = validatePullRequestTemplates(argPullRequestTemplates); err != nil {
+			return fmt.Errorf("could not load pull request templates, %w", err)
+		}
+
+		argRequireIssueLink := c.Bool("require-issue-link")
+		if !c.IsSet("require-issue-link") {
+			argRequireIssueLink = argProfile.RequireIssueLink
+		}
+
+		argLanguages := make([]language, 0, len(c.StringSlice("lang")))
+		for _, lang := range c.StringSlice("lang") {
+			lang = strings.TrimSpace(lang)
+			if _, ok := availableLanguages()[language(lang)]; !ok {
+				return fmt.Errorf("%w `%s`", ErrInvalidLanguage, lang)
+			}
+			if !slices.Contains(argLanguages, language(lang)) {
+				argLanguages = append(argLanguages, language(lang))
+			}
+		}
+		if len(argLanguages) == 0 {
+			argLanguages = append(argLanguages, languageEnglish)
+		}
+
+		cocTemplate := cocTemplates()[argCOC]
+		if argCOC == cocCustom && !c.Bool("disable-coc") {
+			data, err := os.ReadFile(argCOCFile)
+			if err != nil {
+				return fmt.Errorf("could not read coc file, %w", err)
+			}
+			cocTemplate = string(data)
+		}
+
 		targetFolder := strings.Join(
 			[]string{k.cwd, argRepositoryName},
 			string(os.PathSeparator),
@@ -244,6 +556,9 @@ func (k *cmd) actions() func(*cli.Context) error {
 		argDisableFork := c.Bool("disable-fork")
 		argDisableCOC := c.Bool("disable-coc")
 		argDisableBumpVersion := c.Bool("disable-bumpversion")
+		argDisableChangelog := c.Bool("disable-changelog")
+		argDisableContributing := c.Bool("disable-contributing")
+		argDisableSupport := c.Bool("disable-support")
 		argLicenseDescription := availableLicenseTypes()[licenseType(argLicense)]
 
 		argDisableCodeowners := c.Bool("disable-codeowners")
@@ -257,50 +572,149 @@ func (k *cmd) actions() func(*cli.Context) error {
 			GitHubUsername:     argUserName,
 			ProjectName:        argProjectName,
 			RepositoryName:     argRepositoryName,
+			Version:            argInitialVersion,
+			Versioning:         argVersioning.String(),
 			License:            argLicense,
 			LicenseDescription: argLicenseDescription,
+			LicenseFiles:       licenseType(argLicense).files(),
 			AddLicense:         !argNoLicense,
 			AddForkInfo:        !argDisableFork,
 			AddCOC:             !argDisableCOC,
-			AddBumpVersion:     !argDisableBumpVersion,
+			AddBumpVersion:     !argDisableBumpVersion && versioning.BumpVersion,
+			AddChangelog:       !argDisableChangelog,
+			AddContributing:    !argDisableContributing,
+			AddSupport:         !argDisableSupport,
 
 			AddCodeowners:          !argDisableCodeowners,
 			AddFunding:             !argDisableFunding,
 			AddPullRequestTemplate: !argDisablePullRequestTemplate,
 			AddSecurity:            !argDisableSecurity,
 			AddIssueTemplate:       !argDisableIssueTemplate,
+
+			Go: goVars,
+		}
+		if reEmail.MatchString(argEmail) {
+			readmeVars.Email = argEmail
+		}
+		if argProjectStyles.has(projectStylePython) {
+			readmeVars.Python = newPythonVariables(argRepositoryName, argProjectStyles.root(projectStylePython))
+		}
+		readmeVars.Rust = rustVars
+		if argProjectStyles.has(projectStyleNode) {
+			readmeVars.Node = newNodeVariables(argRepositoryName, argProjectStyles.root(projectStyleNode), c.Bool("ts"))
 		}
 
-		var createGitHubFolder bool
-		if readmeVars.AddCodeowners || readmeVars.AddFunding || readmeVars.AddPullRequestTemplate ||
-			readmeVars.AddIssueTemplate {
-			createGitHubFolder = true
+		readmeVars.Docker = dockerVars
+		readmeVars.Action = actionVars
+		readmeVars.Terraform = terraformVars
+		readmeVars.Helm = helmVars
+
+		badges := selectBadges(&readmeVars, styleDefinition, argBadges, argDisabledBadges)
+		readmeVars.Badges = renderBadges(badges, badgeVariables{
+			GitHubUsername: argUserName,
+			RepositoryName: argRepositoryName,
+			Version:        argInitialVersion,
+			DynamicVersion: versioning.DynamicBadge,
+			Workflows:      styleDefinition.Workflows,
+			LicenseFiles:   readmeVars.LicenseFiles,
+			NPMPackage:     readmeVars.Node.Package,
+			Layers:         argProjectStyles,
+		})
+
+		repoMetadata := newMetadata()
+		repoMetadata.ProjectStyle = argProjectStyles.String()
+		repoMetadata.GoLayout = goVars.Layout
+		repoMetadata.TypeScript = readmeVars.Node.TypeScript
+		repoMetadata.ActionType = readmeVars.Action.Type
+		repoMetadata.Versioning = argVersioning.String()
+
+		var cocContacts []string
+		argCOCReportURL := c.String("coc-report-url")
+		if readmeVars.AddCOC {
+			cocContacts = c.StringSlice("coc-contact")
+			if len(cocContacts) == 0 {
+				cocContacts = []string{argEmail}
+			}
+
+			repoMetadata.CodeOfConduct = &metadataCodeOfConduct{
+				Type:      argCOC.String(),
+				Version:   argCOC.Version(),
+				Contacts:  cocContacts,
+				ReportURL: argCOCReportURL,
+			}
 		}
 
-		if createGitHubFolder {
-			fmt.Println("createGitHubFolder", createGitHubFolder)
+		readmeLanguages := make([]readmeLanguageLink, 0, len(argLanguages))
+		if len(argLanguages) > 1 {
+			for i, lang := range argLanguages {
+				readmeLanguages = append(readmeLanguages, readmeLanguageLink{
+					Name:     availableLanguages()[lang],
+					FileName: localizedFileName(fnReadme, lang, i == 0),
+				})
+			}
 		}
 
-		readmeFilePath := strings.Join(
-			[]string{targetFolder, fnReadme},
-			string(os.PathSeparator),
-		)
+		readmeFileNames := make([]string, 0, len(argLanguages))
+		for i, lang := range argLanguages {
+			primary := i == 0
+			readmeVars.Text = readmeTranslations()[lang]
+			readmeVars.Languages = readmeLanguages
+			readmeVars.COCFileName = fnCOC
+
+			if readmeVars.AddCOC {
+				localizedCOCTemplate, ok := cocTemplate, primary
+				if argCOC != cocCustom {
+					if tmpl, translated := cocTemplateFor(argCOC, lang); translated {
+						localizedCOCTemplate, ok = tmpl, true
+					} else if primary {
+						fmt.Fprintf(wr, "%s has no %s translation, using English\n", argCOC, lang)
+					}
+				}
 
-		if err := k.GenerateTextFromTemplate(readmeFilePath, &readmeVars, templateREADME); err != nil {
-			return fmt.Errorf("could not generate %s file, %w", fnReadme, err)
-		}
+				if ok {
+					readmeVars.COCFileName = localizedFileName(fnCOC, lang, primary)
+
+					cocFilePath := strings.Join(
+						[]string{targetFolder, readmeVars.COCFileName},
+						string(os.PathSeparator),
+					)
+
+					codeOfConductVars := codeOfConductVariables{
+						ProjectName: argProjectName,
+						Email:       cocContacts[0],
+						Contacts:    cocContacts,
+						ReportURL:   argCOCReportURL,
+					}
+
+					var content any = &codeOfConductVars
+					if argCOC == cocCustom {
+						// custom text is written as is, not parsed as a template.
+						content, localizedCOCTemplate = customCOCText(cocTemplate, &codeOfConductVars), "{{.}}"
+					}
+
+					if err := k.GenerateTextFromTemplate(cocFilePath, content, localizedCOCTemplate); err != nil {
+						return fmt.Errorf("could not generate %s file, %w", readmeVars.COCFileName, err)
+					}
+				}
+			}
 
-		if readmeVars.AddCOC {
-			cocFilePath := strings.Join(
-				[]string{targetFolder, fnCOC},
+			readmeVars.Sections, err = renderReadmeSections(readmeSections, &readmeVars)
+			if err != nil {
+				return fmt.Errorf("could not generate %s file, %w", fnReadme, err)
+			}
+
+			readmeFileName := localizedFileName(fnReadme, lang, primary)
+			readmeFilePath := strings.Join(
+				[]string{targetFolder, readmeFileName},
 				string(os.PathSeparator),
 			)
 
-			codeOfConductVars := struct{ Email string }{argEmail}
-
-			if err := k.GenerateTextFromTemplate(cocFilePath, &codeOfConductVars, templateCOC); err != nil {
-				return fmt.Errorf("could not generate %s file, %w", fnCOC, err)
+			if err := k.GenerateTextFromTemplate(readmeFilePath, &readmeVars, templateREADME); err != nil {
+				return fmt.Errorf("could not generate %s file, %w", readmeFileName, err)
 			}
+
+			readmeFileNames = append(readmeFileNames, readmeFileName)
+			repoMetadata.Languages = append(repoMetadata.Languages, lang.String())
 		}
 
 		if readmeVars.AddLicense {
@@ -360,6 +774,26 @@ func (k *cmd) actions() func(*cli.Context) error {
 					return fmt.Errorf("could not generate %s file, %w", fnLicense, err)
 				}
 
+			case licenseMITApache20.String():
+				licenseParams := licenseMITVariables{
+					FullName: argFullName,
+					Year:     now.Year(),
+				}
+
+				for _, dual := range []struct{ fileName, template string }{
+					{fileName: fnLicenseMIT, template: templateLicenseMIT},
+					{fileName: fnLicenseApache, template: templateLicenseAPACHE20},
+				} {
+					dualLicenseFilePath := strings.Join(
+						[]string{targetFolder, dual.fileName},
+						string(os.PathSeparator),
+					)
+
+					if err := k.GenerateTextFromTemplate(dualLicenseFilePath, &licenseParams, dual.template); err != nil {
+						return fmt.Errorf("could not generate %s file, %w", dual.fileName, err)
+					}
+				}
+
 			case licenseMIT.String(), licenseMITNoAttribution.String():
 				licenseParams := licenseMITVariables{
 					FullName: argFullName,
@@ -377,16 +811,240 @@ func (k *cmd) actions() func(*cli.Context) error {
 			}
 		}
 
+		contributingVars := contributingVariables{
+			readmeVariables:     &readmeVars,
+			COCFileName:         fnCOC,
+			Agreement:           argAgreement.String(),
+			CLAURL:              argCLAURL,
+			ConventionalCommits: argVersioning.usesConventionalCommits(),
+			DevelopmentCommands: styleDefinition.DevelopmentCommands,
+			EnvironmentCommands: styleDefinition.EnvironmentCommands,
+		}
+
+		if readmeVars.AddContributing {
+			contributingFilePath := strings.Join(
+				[]string{targetFolder, fnContributing},
+				string(os.PathSeparator),
+			)
+
+			if err := k.GenerateTextFromTemplate(contributingFilePath, &contributingVars, templateContributing); err != nil {
+				return fmt.Errorf("could not generate %s file, %w", fnContributing, err)
+			}
+		}
+
+		if readmeVars.AddSupport {
+			supportFilePath := strings.Join(
+				[]string{targetFolder, fnSupport},
+				string(os.PathSeparator),
+			)
+
+			if err := k.GenerateTextFromTemplate(supportFilePath, &contributingVars, templateSupport); err != nil {
+				return fmt.Errorf("could not generate %s file, %w", fnSupport, err)
+			}
+		}
+
+		if readmeVars.AddChangelog {
+			changelogFilePath := strings.Join(
+				[]string{targetFolder, fnChangelog},
+				string(os.PathSeparator),
+			)
+
+			if err := k.GenerateTextFromTemplate(changelogFilePath, &readmeVars, templateChangelog); err != nil {
+				return fmt.Errorf("could not generate %s file, %w", fnChangelog, err)
+			}
+		}
+
+		citationVars := citationVariables{
+			Title:   argProjectName,
+			Author:  newCitationAuthor(argFullName, argEmail, argAffiliation, argORCID),
+			URL:     argWebsite,
+			Version: argInitialVersion,
+		}
+		if argUserName != "" {
+			citationVars.RepositoryCode = "https://github.com/" + argUserName + "/" + argRepositoryName
+		}
+		if spdx := licenseType(argLicense).SPDX(); readmeVars.AddLicense && spdx != "" {
+			// dual licenses are listed, both formats read a list as OR.
+			citationVars.Licenses = strings.Split(spdx, " OR ")
+		}
+
+		if argCitation {
+			citationFilePath := strings.Join(
+				[]string{targetFolder, fnCitation},
+				string(os.PathSeparator),
+			)
+
+			if err := k.GenerateTextFromTemplate(citationFilePath, &citationVars, templateCitation); err != nil {
+				return fmt.Errorf("could not generate %s file, %w", fnCitation, err)
+			}
+		}
+
+		if argCodemeta {
+			codemetaFilePath := strings.Join(
+				[]string{targetFolder, fnCodemeta},
+				string(os.PathSeparator),
+			)
+
+			cm := newCodemeta(&citationVars, argProjectStyles.programmingLanguages(&readmeVars))
+			if err := k.writeJSON(codemetaFilePath, cm); err != nil {
+				return fmt.Errorf("could not generate %s file, %w", fnCodemeta, err)
+			}
+		}
+
 		if readmeVars.AddBumpVersion {
 			bumpVersionFilePath := strings.Join(
 				[]string{targetFolder, fnBumpVersion},
 				string(os.PathSeparator),
 			)
 
-			if err := k.GenerateTextFromTemplate(bumpVersionFilePath, nil, templateBumpVersion); err != nil {
+			bumpVersionVars := bumpVersionVariables{
+				Scheme:           argVersioning.String(),
+				CurrentVersion:   argInitialVersion,
+				PrereleaseLabels: prereleaseLabels,
+			}
+			if slices.Contains(badges, badgeVersion) && !versioning.DynamicBadge {
+				for _, readmeFileName := range readmeFileNames {
+					bumpVersionVars.Files = append(bumpVersionVars.Files, bumpVersionFile{Filename: readmeFileName})
+				}
+			}
+			bumpVersionVars.Files = append(
+				bumpVersionVars.Files,
+				styleDefinition.VersionFiles...,
+			)
+			if readmeVars.AddChangelog {
+				bumpVersionVars.Files = append(
+					bumpVersionVars.Files,
+					changelogBumpVersionFiles(argUserName, argRepositoryName)...,
+				)
+			}
+			bumpVersionVars.Files = append(
+				bumpVersionVars.Files,
+				citationBumpVersionFiles(argCitation, argCodemeta)...,
+			)
+
+			if err := k.GenerateTextFromTemplate(bumpVersionFilePath, &bumpVersionVars, templateBumpVersion); err != nil {
 				return fmt.Errorf("could not generate %s file, %w", fnBumpVersion, err)
 			}
 		}
+
+		if err := k.generateStyleFiles(targetFolder, argProjectStyles, &readmeVars); err != nil {
+			return fmt.Errorf("could not generate project style files, %w", err)
+		}
+
+		if readmeVars.AddCodeowners {
+			if len(codeownersRules) == 0 {
+				fmt.Fprintf(wr, "%s skipped, no owners: set --username or codeowners in profile\n", fnCodeowners)
+			} else {
+				codeownersFilePath := strings.Join(
+					[]string{targetFolder, fnCodeowners},
+					string(os.PathSeparator),
+				)
+
+				codeownersVars := codeownersVariables{Rules: codeownersRules}
+				if err := k.GenerateTextFromTemplate(codeownersFilePath, &codeownersVars, templateCodeowners); err != nil {
+					return fmt.Errorf("could not generate %s file, %w", fnCodeowners, err)
+				}
+			}
+		}
+
+		if readmeVars.AddFunding {
+			if len(fundingVars.Entries) == 0 {
+				fmt.Fprintf(wr, "%s skipped, no funding platform: set --funding or funding in profile\n", fnFunding)
+			} else {
+				fundingFilePath := strings.Join(
+					[]string{targetFolder, fnFunding},
+					string(os.PathSeparator),
+				)
+
+				if err := k.GenerateTextFromTemplate(fundingFilePath, &fundingVars, templateFunding); err != nil {
+					return fmt.Errorf("could not generate %s file, %w", fnFunding, err)
+				}
+			}
+		}
+
+		if readmeVars.AddSecurity {
+			securityVars.readmeVariables = &readmeVars
+			securityVars.SupportedVersions = supportedVersions(argInitialVersion, argVersioning)
+			securityVars.Languages = repoMetadata.Languages
+
+			if len(securityVars.Contacts) == 0 && !securityVars.PrivateReporting {
+				fmt.Fprintf(wr, "%s skipped, no reporting channel: set --email, --username or --security-contact\n", fnSecurity)
+			} else {
+				securityFilePath := strings.Join(
+					[]string{targetFolder, fnSecurity},
+					string(os.PathSeparator),
+				)
+
+				if err := k.GenerateTextFromTemplate(securityFilePath, &securityVars, templateSecurity); err != nil {
+					return fmt.Errorf("could not generate %s file, %w", fnSecurity, err)
+				}
+
+				if securityVars.Website != "" {
+					securityTXTFilePath := strings.Join(
+						[]string{targetFolder, fnSecurityTXT},
+						string(os.PathSeparator),
+					)
+
+					if err := k.GenerateTextFromTemplate(securityTXTFilePath, &securityVars, templateSecurityTXT); err != nil {
+						return fmt.Errorf("could not generate %s file, %w", fnSecurityTXT, err)
+					}
+				}
+			}
+		}
+
+		if readmeVars.AddPullRequestTemplate {
+			pullRequestVars := pullRequestVariables{
+				readmeVariables:     &readmeVars,
+				Checklist:           styleDefinition.PullRequestChecks,
+				RequireIssueLink:    argRequireIssueLink,
+				ConventionalCommits: argVersioning.usesConventionalCommits(),
+				PullRequestText:     pullRequestTranslations()[argLanguages[0]],
+			}
+
+			if err := k.generatePullRequestTemplates(
+				targetFolder,
+				argPullRequestTemplates,
+				&pullRequestVars,
+			); err != nil {
+				return fmt.Errorf("could not generate pull request templates, %w", err)
+			}
+		}
+
+		if readmeVars.AddIssueTemplate {
+			issueFormVars := issueFormVariables{
+				readmeVariables: &readmeVars,
+				IssueFields:     styleDefinition.IssueFields,
+				IssueFormText:   issueFormTranslations()[argLanguages[0]],
+			}
+
+			if err := k.generateTemplateFiles(targetFolder, templateIssueForms, issueFormFiles(), &issueFormVars); err != nil {
+				return fmt.Errorf("could not generate issue forms, %w", err)
+			}
+		}
+
+		versioningVars := versioningVariables{
+			readmeVariables: &readmeVars,
+			ReleaseType:     argProjectStyles.releaseType(),
+		}
+		for _, file := range styleDefinition.VersionFiles {
+			if !slices.Contains(versioningVars.ExtraFiles, file.Filename) {
+				versioningVars.ExtraFiles = append(versioningVars.ExtraFiles, file.Filename)
+			}
+		}
+
+		if err := k.generateTemplateFiles(targetFolder, templateVersioning, versioning.Files, &versioningVars); err != nil {
+			return fmt.Errorf("could not generate versioning files, %w", err)
+		}
+
+		metadataFilePath := strings.Join(
+			[]string{targetFolder, fnMetadata},
+			string(os.PathSeparator),
+		)
+
+		if err := k.writeJSON(metadataFilePath, repoMetadata); err != nil {
+			return fmt.Errorf("could not generate %s file, %w", fnMetadata, err)
+		}
+
 		fmt.Fprintf(wr, "your new project is ready at %s\n", targetFolder)
 
 		return nil
@@ -0,0 +1,178 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+)
+
+type (
+	badgeType  string
+	badgeTypes map[badgeType]string
+
+	badgeVariables struct {
+		GitHubUsername string
+		RepositoryName string
+		Version        string
+		DynamicVersion bool
+		Workflows      []styleWorkflow
+		LicenseFiles   []licenseFile
+		NPMPackage     string
+		Layers         styleLayers
+	}
+)
+
+func (bt badgeType) String() string {
+	return string(bt)
+}
+
+const (
+	badgeVersion      = badgeType("version")
+	badgeLicense      = badgeType("license")
+	badgeCI           = badgeType("ci")
+	badgeCodecov      = badgeType("codecov")
+	badgeGoReportCard = badgeType("goreportcard")
+	badgePkgGoDev     = badgeType("pkggodev")
+	badgeScorecard    = badgeType("scorecard")
+	badgeGoVersion    = badgeType("goversion")
+
+	badgePythonVersion = badgeType("pythonversion")
+	badgeRuff          = badgeType("ruff")
+
+	badgeNPM = badgeType("npm")
+)
+
+// sentinel errors.
+var ErrInvalidBadge = errors.New("invalid badge option")
+
+func availableBadgeTypes() badgeTypes {
+	return badgeTypes{
+		badgeVersion:      "version badge, kept in sync by bumpversion or read from git tags",
+		badgeLicense:      "license of the repository",
+		badgeCI:           "status of generated GitHub workflows",
+		badgeCodecov:      "codecov coverage",
+		badgeGoReportCard: "Go Report Card grade",
+		badgePkgGoDev:     "pkg.go.dev reference",
+		badgeScorecard:    "OpenSSF Scorecard",
+		badgeGoVersion:    "Go version from go.mod",
+
+		badgePythonVersion: "required Python version from pyproject.toml",
+		badgeRuff:          "linted with Ruff",
+
+		badgeNPM: "npm package version",
+	}
+}
+
+func validateBadges(names ...[]string) error {
+	for _, name := range slices.Concat(names...) {
+		if _, ok := availableBadgeTypes()[badgeType(name)]; !ok {
+			return fmt.Errorf("%w `%s`", ErrInvalidBadge, name)
+		}
+	}
+
+	return nil
+}
+
+// selectBadges picks badges for enabled artifacts and project style. Explicit
+// list replaces the selection, disabled ones are removed afterwards.
+func selectBadges(vars *readmeVariables, def projectStyleDefinition, explicit, disabled []string) []badgeType {
+	var badges []badgeType
+	if len(explicit) > 0 {
+		for _, name := range explicit {
+			badges = append(badges, badgeType(name))
+		}
+	} else {
+		if vars.AddBumpVersion || !versioningDefinitions()[versioningScheme(vars.Versioning)].BumpVersion {
+			badges = append(badges, badgeVersion)
+		}
+		if vars.AddLicense {
+			badges = append(badges, badgeLicense)
+		}
+		if len(def.Workflows) > 0 {
+			badges = append(badges, badgeCI)
+		}
+		badges = append(badges, def.Badges...)
+	}
+
+	return slices.DeleteFunc(badges, func(bt badgeType) bool {
+		return slices.Contains(disabled, bt.String())
+	})
+}
+
+// renderBadges returns markdown of each badge, ci badge produces one line per
+// workflow.
+func renderBadges(badges []badgeType, vars badgeVariables) []string {
+	repo := vars.GitHubUsername + "/" + vars.RepositoryName
+	module := path.Join("github.com", repo, vars.Layers.root(projectStyleGo))
+
+	rendered := make([]string, 0, len(badges))
+	for _, bt := range badges {
+		switch bt {
+		case badgeVersion:
+			if vars.DynamicVersion {
+				rendered = append(rendered, fmt.Sprintf(
+					"[![Version](https://img.shields.io/github/v/tag/%s?sort=semver&include_prereleases)]"+
+						"(https://github.com/%s/tags)", repo, repo))
+
+				continue
+			}
+			rendered = append(rendered, fmt.Sprintf(
+				"![Version](https://img.shields.io/badge/version-%s-orange.svg)", vars.Version))
+		case badgeLicense:
+			if len(vars.LicenseFiles) == 1 {
+				rendered = append(rendered, fmt.Sprintf(
+					"[![License](https://img.shields.io/github/license/%s)](%s)", repo, vars.LicenseFiles[0].FileName))
+
+				continue
+			}
+			// github can not detect dual licenses, each one gets a static badge.
+			for _, lf := range vars.LicenseFiles {
+				rendered = append(rendered, fmt.Sprintf(
+					"[![License: %s](https://img.shields.io/badge/license-%s-blue.svg)](%s)",
+					lf.SPDX, strings.ReplaceAll(lf.SPDX, "-", "--"), lf.FileName))
+			}
+		case badgeCI:
+			for _, wf := range vars.Workflows {
+				url := fmt.Sprintf("https://github.com/%s/actions/workflows/%s", repo, wf.FileName)
+				rendered = append(rendered, fmt.Sprintf("[![%s](%s/badge.svg)](%s)", wf.Name, url, url))
+			}
+		case badgeCodecov:
+			rendered = append(rendered, fmt.Sprintf(
+				"[![codecov](https://codecov.io/gh/%s/branch/main/graph/badge.svg)](https://codecov.io/gh/%s)", repo, repo))
+		case badgeGoReportCard:
+			rendered = append(rendered, fmt.Sprintf(
+				"[![Go Report Card](https://goreportcard.com/badge/%s)](https://goreportcard.com/report/%s)",
+				module, module))
+		case badgePkgGoDev:
+			rendered = append(rendered, fmt.Sprintf(
+				"[![Go Reference](https://pkg.go.dev/badge/%s.svg)](https://pkg.go.dev/%s)", module, module))
+		case badgeScorecard:
+			// scorecard scores whole repository, not the go module under a root.
+			rendered = append(rendered, fmt.Sprintf(
+				"[![OpenSSF Scorecard](https://api.scorecard.dev/projects/github.com/%s/badge)]"+
+					"(https://scorecard.dev/viewer/?uri=github.com/%s)", repo, repo))
+		case badgeGoVersion:
+			goVersion := "https://img.shields.io/github/go-mod/go-version/" + repo
+			if root := vars.Layers.root(projectStyleGo); root != "" {
+				goVersion += "?filename=" + url.QueryEscape(path.Join(root, "go.mod"))
+			}
+			rendered = append(rendered, "![Go Version]("+goVersion+")")
+		case badgePythonVersion:
+			pyproject := url.QueryEscape("https://raw.githubusercontent.com/" +
+				path.Join(repo, "main", vars.Layers.root(projectStylePython), "pyproject.toml"))
+			rendered = append(rendered, fmt.Sprintf(
+				"![Python Version](https://img.shields.io/python/required-version-toml?tomlFilePath=%s)", pyproject))
+		case badgeRuff:
+			rendered = append(rendered, "[![Ruff](https://img.shields.io/endpoint?url="+
+				"https://raw.githubusercontent.com/astral-sh/ruff/main/assets/badge/v2.json)](https://github.com/astral-sh/ruff)")
+		case badgeNPM:
+			rendered = append(rendered, fmt.Sprintf(
+				"[![npm](https://img.shields.io/npm/v/%s)](https://www.npmjs.com/package/%s)", vars.NPMPackage, vars.NPMPackage))
+		}
+	}
+
+	return rendered
+}
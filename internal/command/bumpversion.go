@@ -0,0 +1,41 @@
+package command
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const defaultInitialVersion = "0.0.0"
+
+type (
+	// bumpVersionFile is a [[tool.bumpversion.files]] entry, empty search
+	// falls back to bump-my-version default which is "{current_version}".
+	// IgnoreMissing skips the entry when search is not found in the file.
+	bumpVersionFile struct {
+		Filename      string
+		Search        string
+		Replace       string
+		IgnoreMissing bool
+	}
+
+	bumpVersionVariables struct {
+		Scheme           string
+		CurrentVersion   string
+		PrereleaseLabels []string
+		Files            []bumpVersionFile
+	}
+)
+
+// sentinel errors.
+var ErrInvalidVersion = errors.New("invalid version for versioning scheme")
+
+// tomlString quotes s as a TOML literal string, strings that can not be
+// written literally (new lines, single quotes) become basic strings.
+func tomlString(s string) string {
+	if strings.ContainsAny(s, "'\n") {
+		return strconv.Quote(s)
+	}
+
+	return "'" + s + "'"
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	_ "embed"
+	"fmt"
+)
+
+//go:embed templates/changelog.gotxt
+var templateChangelog string
+
+const (
+	fnChangelog = "CHANGELOG.md"
+
+	changelogUnreleasedHeading = "## [Unreleased]"
+)
+
+// changelogBumpVersionFiles returns bump-my-version entries that move the
+// Unreleased changes under a new version heading and update links. Unreleased
+// links to commits until the first release, then compares with last tag;
+// only one of the link entries matches, so both ignore missing search.
+func changelogBumpVersionFiles(username, repository string) []bumpVersionFile {
+	repositoryURL := fmt.Sprintf("https://github.com/%s/%s", username, repository)
+	compareURL := repositoryURL + "/compare"
+
+	return []bumpVersionFile{
+		{
+			Filename: fnChangelog,
+			Search:   changelogUnreleasedHeading,
+			Replace:  changelogUnreleasedHeading + "\n\n## [{new_version}] - {now:%Y-%m-%d}",
+		},
+		{
+			Filename: fnChangelog,
+			Search:   "[Unreleased]: " + repositoryURL + "/commits/HEAD",
+			Replace: "[Unreleased]: " + compareURL + "/v{new_version}...HEAD\n" +
+				"[{new_version}]: " + repositoryURL + "/releases/tag/v{new_version}",
+			IgnoreMissing: true,
+		},
+		{
+			Filename: fnChangelog,
+			Search:   "[Unreleased]: " + compareURL + "/v{current_version}...HEAD",
+			Replace: "[Unreleased]: " + compareURL + "/v{new_version}...HEAD\n" +
+				"[{new_version}]: " + compareURL + "/v{current_version}...v{new_version}",
+			IgnoreMissing: true,
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package command
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+//go:embed templates/citation.gotxt
+var templateCitation string
+
+type (
+	citationAuthor struct {
+		GivenNames  string
+		FamilyNames string
+		Email       string
+		Affiliation string
+		ORCID       string
+	}
+
+	// licenseFile is a license file with SPDX identifier of its license.
+	licenseFile struct {
+		SPDX     string
+		FileName string
+	}
+
+	citationVariables struct {
+		Title          string
+		Author         citationAuthor
+		RepositoryCode string
+		URL            string
+		Licenses       []string
+		Version        string
+	}
+
+	codemetaOrganization struct {
+		Type string `json:"@type"`
+		Name string `json:"name"`
+	}
+
+	codemetaPerson struct {
+		Type        string                `json:"@type"`
+		ID          string                `json:"@id,omitempty"`
+		GivenName   string                `json:"givenName,omitempty"`
+		FamilyName  string                `json:"familyName"`
+		Email       string                `json:"email,omitempty"`
+		Affiliation *codemetaOrganization `json:"affiliation,omitempty"`
+	}
+
+	// codemetaValues is written as a string for a single value.
+	codemetaValues []string
+
+	// codemeta is a codemeta.json document, see https://codemeta.github.io/terms/
+	codemeta struct {
+		Context             string           `json:"@context"`
+		Type                string           `json:"@type"`
+		Name                string           `json:"name"`
+		Version             string           `json:"version"`
+		License             codemetaValues   `json:"license,omitempty"`
+		CodeRepository      string           `json:"codeRepository,omitempty"`
+		IssueTracker        string           `json:"issueTracker,omitempty"`
+		URL                 string           `json:"url,omitempty"`
+		ProgrammingLanguage codemetaValues   `json:"programmingLanguage,omitempty"`
+		Author              []codemetaPerson `json:"author"`
+	}
+)
+
+const (
+	fnCitation = "CITATION.cff"
+	fnCodemeta = "codemeta.json"
+
+	codemetaContext = "https://w3id.org/codemeta/3.0"
+	orcidURLPrefix  = "https://orcid.org/"
+)
+
+var reORCID = regexp.MustCompile(`^[0-9]{4}-[0-9]{4}-[0-9]{4}-[0-9]{3}[0-9X]$`)
+
+// sentinel errors.
+var (
+	ErrInvalidORCID           = errors.New("invalid orcid")
+	ErrCitationAuthorRequired = errors.New("full name required for citation")
+)
+
+// SPDX returns SPDX license identifier of the license type.
+func (lt licenseType) SPDX() string {
+	return map[licenseType]string{
+		licenseMIT:              "MIT",
+		licenseMITNoAttribution: "MIT-0",
+		licenseGNUAfferoGPL30:   "AGPL-3.0-only",
+		licenseGNUGPL30:         "GPL-3.0-only",
+		licenseGNULesserGPL30:   "LGPL-3.0-only",
+		licenseMOZP20:           "MPL-2.0",
+		licenseAPACHE20:         "Apache-2.0",
+		licenseBSL10:            "BSL-1.0",
+		licenseTHEUNL:           "Unlicense",
+		licenseMITApache20:      "MIT OR Apache-2.0",
+	}[lt]
+}
+
+// files returns license files to link, dual licenses have a file for each.
+func (lt licenseType) files() []licenseFile {
+	if lt == licenseMITApache20 {
+		return []licenseFile{
+			{SPDX: "MIT", FileName: fnLicenseMIT},
+			{SPDX: "Apache-2.0", FileName: fnLicenseApache},
+		}
+	}
+
+	return []licenseFile{{SPDX: lt.SPDX(), FileName: fnLicense}}
+}
+
+// Link returns markdown link of the license file.
+func (lf licenseFile) Link() string {
+	return "[" + lf.SPDX + "](" + lf.FileName + ")"
+}
+
+// normalizeORCID accepts a bare ORCID iD or its https://orcid.org/ URL and
+// returns the URL form, the check digit is verified with ISO 7064 MOD 11-2.
+func normalizeORCID(value string) (string, error) {
+	id := strings.TrimPrefix(strings.TrimPrefix(value, orcidURLPrefix), "http://orcid.org/")
+	if !reORCID.MatchString(id) {
+		return "", fmt.Errorf("%w `%s`, expected 0000-0000-0000-0000", ErrInvalidORCID, value)
+	}
+
+	digits := strings.ReplaceAll(id, "-", "")
+
+	total := 0
+	for _, r := range digits[:len(digits)-1] {
+		total = (total + int(r-'0')) * 2
+	}
+
+	check := byte('0' + (12-total%11)%11)
+	if check == '0'+10 {
+		check = 'X'
+	}
+	if digits[len(digits)-1] != check {
+		return "", fmt.Errorf("%w `%s`, checksum mismatch", ErrInvalidORCID, value)
+	}
+
+	return orcidURLPrefix + id, nil
+}
+
+// newCitationAuthor splits full name as given names and the last word as
+// family name.
+func newCitationAuthor(fullName, email, affiliation, orcid string) citationAuthor {
+	author := citationAuthor{Affiliation: affiliation, ORCID: orcid}
+
+	names := strings.Fields(fullName)
+	if len(names) > 0 {
+		author.GivenNames = strings.Join(names[:len(names)-1], " ")
+		author.FamilyNames = names[len(names)-1]
+	}
+	if reEmail.MatchString(email) {
+		author.Email = email
+	}
+
+	return author
+}
+
+// MarshalJSON implements json.Marshaler.
+func (cv codemetaValues) MarshalJSON() ([]byte, error) {
+	var v any = []string(cv)
+	if len(cv) == 1 {
+		v = cv[0]
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode codemeta values, %w", err)
+	}
+
+	return data, nil
+}
+
+func newCodemeta(vars *citationVariables, programmingLanguages []string) *codemeta {
+	person := codemetaPerson{
+		Type:       "Person",
+		ID:         vars.Author.ORCID,
+		GivenName:  vars.Author.GivenNames,
+		FamilyName: vars.Author.FamilyNames,
+		Email:      vars.Author.Email,
+	}
+	if vars.Author.Affiliation != "" {
+		person.Affiliation = &codemetaOrganization{Type: "Organization", Name: vars.Author.Affiliation}
+	}
+
+	cm := &codemeta{
+		Context:             codemetaContext,
+		Type:                "SoftwareSourceCode",
+		Name:                vars.Title,
+		Version:             vars.Version,
+		CodeRepository:      vars.RepositoryCode,
+		URL:                 vars.URL,
+		ProgrammingLanguage: programmingLanguages,
+		Author:              []codemetaPerson{person},
+	}
+	for _, license := range vars.Licenses {
+		cm.License = append(cm.License, "https://spdx.org/licenses/"+license)
+	}
+	if vars.RepositoryCode != "" {
+		cm.IssueTracker = vars.RepositoryCode + "/issues"
+	}
+
+	return cm
+}
+
+// citationBumpVersionFiles returns bump-my-version entries for version fields
+// of citation files.
+func citationBumpVersionFiles(addCitation, addCodemeta bool) []bumpVersionFile {
+	var files []bumpVersionFile
+	if addCitation {
+		files = append(files, bumpVersionFile{
+			Filename: fnCitation,
+			Search:   `version: "{current_version}"`,
+			Replace:  `version: "{new_version}"`,
+		})
+	}
+	if addCodemeta {
+		files = append(files, bumpVersionFile{
+			Filename: fnCodemeta,
+			Search:   `"version": "{current_version}"`,
+			Replace:  `"version": "{new_version}"`,
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+)
+
+//go:embed templates/coc/contributor-covenant-14.gotxt
+var templateCOCContributorCovenant14 string
+
+//go:embed templates/coc/contributor-covenant-20.gotxt
+var templateCOCContributorCovenant20 string
+
+//go:embed templates/coc/contributor-covenant-21.gotxt
+var templateCOCContributorCovenant21 string
+
+//go:embed templates/coc/citizen.gotxt
+var templateCOCCitizen string
+
+type (
+	cocType  string
+	cocTypes map[cocType]string
+
+	codeOfConductVariables struct {
+		ProjectName string
+		Email       string
+		Contacts    []string
+		ReportURL   string
+	}
+)
+
+func (ct cocType) String() string {
+	return string(ct)
+}
+
+const (
+	cocContributorCovenant14 = cocType("cc-14")
+	cocContributorCovenant20 = cocType("cc-20")
+	cocContributorCovenant21 = cocType("cc-21")
+	cocCitizen               = cocType("citizen")
+	cocCustom                = cocType("custom")
+)
+
+// sentinel errors.
+var (
+	ErrInvalidCOC      = errors.New("invalid code of conduct option")
+	ErrCOCFileRequired = errors.New("coc file required for custom code of conduct")
+)
+
+func availableCOCTypes() cocTypes {
+	return cocTypes{
+		cocContributorCovenant14: "Contributor Covenant 1.4",
+		cocContributorCovenant20: "Contributor Covenant 2.0",
+		cocContributorCovenant21: "Contributor Covenant 2.1",
+		cocCitizen:               "Citizen Code of Conduct 2.3",
+	}
+}
+
+func cocTemplates() map[cocType]string {
+	return map[cocType]string{
+		cocContributorCovenant14: templateCOCContributorCovenant14,
+		cocContributorCovenant20: templateCOCContributorCovenant20,
+		cocContributorCovenant21: templateCOCContributorCovenant21,
+		cocCitizen:               templateCOCCitizen,
+	}
+}
+
+// Version returns upstream version of the code of conduct text, custom texts
+// have no version.
+func (ct cocType) Version() string {
+	switch ct {
+	case cocContributorCovenant14:
+		return "1.4"
+	case cocContributorCovenant20:
+		return "2.0"
+	case cocContributorCovenant21:
+		return "2.1"
+	case cocCitizen:
+		return "2.3"
+	}
+
+	return ""
+}
+
+// customCOCText fills documented placeholders of a custom code of conduct,
+// rest of the text is kept verbatim so literal braces are safe.
+func customCOCText(text string, vars *codeOfConductVariables) string {
+	return strings.NewReplacer(
+		"{{.ProjectName}}", vars.ProjectName,
+		"{{.Email}}", vars.Email,
+		"{{.Contacts}}", strings.Join(vars.Contacts, ", "),
+		"{{.ReportURL}}", vars.ReportURL,
+	).Replace(text)
+}
@@ -0,0 +1,250 @@
+package command
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+//go:embed templates/codeowners.gotxt
+var templateCodeowners string
+
+type (
+	// codeownersRule is a CODEOWNERS line, rules are written in order and the
+	// last matching pattern takes precedence on GitHub.
+	codeownersRule struct {
+		Pattern string   `json:"pattern"`
+		Owners  []string `json:"owners"`
+		Line    int      `json:"-"`
+	}
+
+	codeownersVariables struct {
+		Rules []codeownersRule
+	}
+)
+
+const fnCodeowners = ".github/CODEOWNERS"
+
+var (
+	reCodeownersUser = regexp.MustCompile(`^@[A-Za-z0-9](?:-?[A-Za-z0-9]){0,38}$`)
+	reCodeownersTeam = regexp.MustCompile(`^@[A-Za-z0-9](?:-?[A-Za-z0-9]){0,38}/[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+)
+
+// sentinel errors.
+var (
+	ErrInvalidCodeownersOwner     = errors.New("invalid codeowners owner")
+	ErrInvalidCodeownersPattern   = errors.New("invalid codeowners pattern")
+	ErrCodeownersNotFound         = errors.New("codeowners file not found")
+	ErrCodeownersPatternUnmatched = errors.New("codeowners pattern does not match any file")
+)
+
+// codeownersFileNames returns CODEOWNERS locations in GitHub lookup order.
+func codeownersFileNames() []string {
+	return []string{fnCodeowners, "CODEOWNERS", "docs/CODEOWNERS"}
+}
+
+func validCodeownersOwner(owner string) bool {
+	return reCodeownersUser.MatchString(owner) ||
+		reCodeownersTeam.MatchString(owner) ||
+		reEmail.MatchString(owner)
+}
+
+func validateCodeownersRules(rules []codeownersRule) error {
+	for _, rule := range rules {
+		// negation, character ranges and escaped spaces are not supported by GitHub.
+		if rule.Pattern == "" || strings.HasPrefix(rule.Pattern, "!") ||
+			strings.ContainsAny(rule.Pattern, "[] \t") {
+			return fmt.Errorf("%w `%s`", ErrInvalidCodeownersPattern, rule.Pattern)
+		}
+
+		for _, owner := range rule.Owners {
+			if !validCodeownersOwner(owner) {
+				return fmt.Errorf("%w `%s` for `%s`", ErrInvalidCodeownersOwner, owner, rule.Pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseCodeowners(fileName string) ([]codeownersRule, error) {
+	file, err := os.Open(filepath.Clean(fileName))
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s, %w", fileName, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var rules []codeownersRule
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if i := strings.Index(text, " #"); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		rules = append(rules, codeownersRule{Pattern: fields[0], Owners: fields[1:], Line: line})
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read %s, %w", fileName, err)
+	}
+
+	return rules, nil
+}
+
+// codeownersPatternRegexp converts a CODEOWNERS pattern to a regexp matching
+// slash separated paths relative to repository root. Patterns follow
+// gitignore rules except `dir/*` does not match nested files.
+func codeownersPatternRegexp(pattern string) *regexp.Regexp {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var sb strings.Builder
+
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			sb.WriteString("[^/]*")
+		case pattern[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	switch {
+	case dirOnly:
+		sb.WriteString("/.*$")
+	case strings.HasSuffix(pattern, "/*"):
+		sb.WriteString("$")
+	default:
+		sb.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.MustCompile(sb.String())
+}
+
+func repositoryFiles(dir string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("could not resolve %s, %w", path, err)
+		}
+		files = append(files, filepath.ToSlash(rel))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not walk %s, %w", dir, err)
+	}
+
+	return files, nil
+}
+
+func (k *cmd) codeownersCheckAction() func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		dir := c.String("dir")
+		if dir == "" {
+			dir = k.cwd
+		}
+
+		var codeownersFile string
+		for _, fileName := range codeownersFileNames() {
+			path := filepath.Join(dir, filepath.FromSlash(fileName))
+			if _, err := os.Stat(path); err == nil {
+				codeownersFile = path
+
+				break
+			}
+		}
+		if codeownersFile == "" {
+			return fmt.Errorf("%w in %s", ErrCodeownersNotFound, dir)
+		}
+
+		rules, err := parseCodeowners(codeownersFile)
+		if err != nil {
+			return fmt.Errorf("could not parse codeowners, %w", err)
+		}
+
+		files, err := repositoryFiles(dir)
+		if err != nil {
+			return fmt.Errorf("could not list repository files, %w", err)
+		}
+
+		wr := c.App.Writer
+
+		var invalidOwner, unmatched bool
+		for _, rule := range rules {
+			for _, owner := range rule.Owners {
+				if !validCodeownersOwner(owner) {
+					fmt.Fprintf(wr, "line %d: invalid owner `%s`\n", rule.Line, owner)
+					invalidOwner = true
+				}
+			}
+
+			re := codeownersPatternRegexp(rule.Pattern)
+			matched := false
+			for _, file := range files {
+				if re.MatchString(file) {
+					matched = true
+
+					break
+				}
+			}
+			if !matched {
+				fmt.Fprintf(wr, "line %d: pattern `%s` does not match any file\n", rule.Line, rule.Pattern)
+				unmatched = true
+			}
+		}
+
+		if invalidOwner {
+			return ErrInvalidCodeownersOwner
+		}
+		if unmatched {
+			return ErrCodeownersPatternUnmatched
+		}
+
+		fmt.Fprintf(wr, "all %d pattern(s) of %s match at least one file\n", len(rules), codeownersFile)
+
+		return nil
+	}
+}
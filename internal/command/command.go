@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -17,10 +18,18 @@ import (
 func templateFilters() template.FuncMap {
 	return template.FuncMap{
 		"Upper": strings.ToUpper,
+		"Join":  strings.Join,
+		"TOML":  tomlString,
+		"JSON":  jsonString,
+		"SPDX":  func(s string) string { return licenseType(s).SPDX() },
+		"Path":  path.Join,
 	}
 }
 
-const filePerm = 0o0644
+const (
+	filePerm = 0o0644
+	dirPerm  = 0o0755
+)
 
 type cmd struct {
 	writer io.Writer
@@ -54,6 +63,10 @@ func (k *cmd) GenerateTextFromTemplate(fileName string, content any, templateStr
 	if k.writer == nil {
 		var file *os.File
 
+		if err = os.MkdirAll(filepath.Dir(filepath.Clean(fileName)), dirPerm); err != nil {
+			return fmt.Errorf("could not create folder: %w", err)
+		}
+
 		file, err = os.OpenFile(filepath.Clean(fileName), os.O_RDWR|os.O_CREATE, filePerm)
 		if err != nil {
 			return fmt.Errorf("could not open file: %w", err)
@@ -129,12 +142,28 @@ func New(options ...Option) (*cmd, error) { //nolint:revive
 		)
 	}
 
+	cocTypeKeys := make([]string, 0, len(availableCOCTypes()))
+	for k := range availableCOCTypes() {
+		cocTypeKeys = append(cocTypeKeys, k.String())
+	}
+	sort.Strings(cocTypeKeys)
+
+	extrasCOCTypes := make([]string, 0, len(cocTypeKeys))
+	for _, k := range cocTypeKeys {
+		extrasCOCTypes = append(
+			extrasCOCTypes,
+			fmt.Sprintf("  - `%s`: %s", k, availableCOCTypes()[cocType(k)]),
+		)
+	}
+
 	extrasHelpFormatted := fmt.Sprintf(
 		extrasHelp(),
 		len(licenseTypeKeys),
 		strings.Join(extrasAvailableLicenses, "\n"),
 		len(projectStyleKeys),
 		strings.Join(extrasProjectStyles, "\n"),
+		len(cocTypeKeys),
+		strings.Join(extrasCOCTypes, "\n"),
 	)
 
 	cli.AppHelpTemplate = fmt.Sprintf("%s%s\n", cli.AppHelpTemplate, extrasHelpFormatted)
@@ -154,8 +183,9 @@ func New(options ...Option) (*cmd, error) { //nolint:revive
 				Email: "ugurozyilmazel@gmail.com",
 			},
 		},
-		Flags:  kommand.getFlags(),
-		Action: kommand.actions(),
+		Flags:    kommand.getFlags(),
+		Commands: kommand.getCommands(),
+		Action:   kommand.actions(),
 	}
 	kommand.app = app
 
@@ -2,11 +2,18 @@ package command_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"gopkg.in/yaml.v3"
+
 	"github.com/vigo/git-init-githubrepo/internal/command"
 	"github.com/vigo/git-init-githubrepo/internal/version"
 )
@@ -189,8 +196,12 @@ func TestCreateAll(t *testing.T) {
 			lookupInLicense: "The MIT License",
 			checkFiles: []string{
 				"CODE_OF_CONDUCT.md",
+				"CHANGELOG.md",
+				"CONTRIBUTING.md",
+				"SUPPORT.md",
 				"LICENSE",
 				".bumpversion.toml",
+				".git-init-githubrepo.json",
 				"README.md",
 			},
 			err: nil,
@@ -343,3 +354,2139 @@ func TestCreateAll(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCodeOfConduct(t *testing.T) {
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+
+	customCOC := strings.Join([]string{t.TempDir(), "coc.md"}, string(os.PathSeparator))
+	if err = os.WriteFile(customCOC, []byte("# {{.ProjectName}} rules\n\nmail {{.Email}}, {{ not a template }}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tempDir := os.TempDir()
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("Failed to change directory to temp: %v", err)
+	}
+
+	defer func() {
+		if err := os.Chdir(originalDir); err != nil {
+			t.Fatalf("Failed to restore original directory: %v", err)
+		}
+	}()
+
+	testCases := []struct {
+		name           string
+		input          []string
+		lookupInCOC    []string
+		lookupMetadata string
+		err            error
+	}{
+		{
+			name: "create with default coc",
+			input: []string{
+				"--email", "vigo@example.com",
+				"--project-name", "test",
+				"--repository-name", "repo",
+			},
+			lookupInCOC:    []string{"version 2.1", "enforcement at\nvigo@example.com."},
+			lookupMetadata: `"version": "2.1"`,
+		},
+		{
+			name: "create with contributor covenant 1.4 and contacts",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--coc", "cc-14",
+				"--coc-contact", "a@example.com",
+				"--coc-contact", "b@example.com",
+				"--coc-report-url", "https://example.com/report",
+			},
+			lookupInCOC: []string{
+				"version 1.4",
+				"a@example.com, b@example.com or by\nusing https://example.com/report",
+			},
+			lookupMetadata: `"type": "cc-14"`,
+		},
+		{
+			name: "create with citizen coc",
+			input: []string{
+				"--project-name", "Citizens",
+				"--repository-name", "repo",
+				"--coc", "citizen",
+			},
+			lookupInCOC:    []string{"# Citizen Code of Conduct", "A primary goal of Citizens"},
+			lookupMetadata: `"version": "2.3"`,
+		},
+		{
+			name: "create with custom coc",
+			input: []string{
+				"--email", "vigo@example.com",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--coc-file", customCOC,
+			},
+			lookupInCOC:    []string{"# test rules", "mail vigo@example.com, {{ not a template }}"},
+			lookupMetadata: `"type": "custom"`,
+		},
+		{
+			name: "create with invalid coc",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--coc", "notexist",
+			},
+			err: command.ErrInvalidCOC,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			args := os.Args[:1]
+			args = append(args, testCase.input...)
+
+			cmd, err := command.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := cmd.Run(args); !errors.Is(err, testCase.err) {
+				t.Errorf("want: %v, got: %v", testCase.err, err)
+			}
+
+			if testCase.err == nil {
+				filePath := strings.Join([]string{tmpFolder, "CODE_OF_CONDUCT.md"}, string(os.PathSeparator))
+
+				data, err := os.ReadFile(filePath)
+				if err != nil {
+					t.Fatalf("can not open file: %v", err)
+				}
+
+				for _, lookup := range testCase.lookupInCOC {
+					if !strings.Contains(string(data), lookup) {
+						t.Errorf("CODE_OF_CONDUCT.md does not contain: %s", lookup)
+					}
+				}
+
+				filePath = strings.Join([]string{tmpFolder, ".git-init-githubrepo.json"}, string(os.PathSeparator))
+
+				data, err = os.ReadFile(filePath)
+				if err != nil {
+					t.Fatalf("can not open file: %v", err)
+				}
+
+				if !strings.Contains(string(data), testCase.lookupMetadata) {
+					t.Errorf(".git-init-githubrepo.json does not contain: %s", testCase.lookupMetadata)
+				}
+			}
+
+			if err := os.RemoveAll(tmpFolder); err != nil {
+				t.Errorf("can not delete temp folder: %v", err)
+			}
+		})
+	}
+}
+
+// testPGPPublicKey is an ed25519 public key generated for tests only.
+const testPGPPublicKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatYbGRYJKwYBBAHaRw8BAQdAuBc/1ilkoqk9IEPlpoU+iOtyMG+0jARlLVOy
+wvydhNm0FVNlYyA8c2VjQGV4YW1wbGUuY29tPoiWBBMWCAA+FiEEQNxM6L3DqbB/
+FvCBDd5wZZnvwmcFAmrWGxkCGwMFCQHhM4AFCwkIBwIGFQoJCAsCBBYCAwECHgEC
+F4AACgkQDd5wZZnvwmcJfwD9HQuL3str8/lFuLJzBO4vicdED/CfBvhC45eHUCO2
+vSoA/RA+sR00/vb8nJqZDb5G8y2DpFL7r/o+Ii0CTUUb+kYB
+=d9VK
+-----END PGP PUBLIC KEY BLOCK-----
+`
+
+func TestCreateWithOptions(t *testing.T) {
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get current directory: %v", err)
+	}
+
+	profileFile := strings.Join([]string{t.TempDir(), "profile.json"}, string(os.PathSeparator))
+	if err = os.WriteFile(profileFile, []byte(`{"badges": ["version", "scorecard"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sectionsProfileFile := strings.Join([]string{t.TempDir(), "profile.json"}, string(os.PathSeparator))
+	sectionsProfile := `{
+  "readme_sections": [
+    {"name": "api", "title": "API", "body": "See pkg.go.dev for {{.RepositoryName}}", "order": 25},
+    {"name": "requirements", "condition": "false"},
+    {"name": "usage", "title": "Examples"}
+  ]
+}`
+	if err = os.WriteFile(sectionsProfileFile, []byte(sectionsProfile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	codeownersProfileFile := strings.Join([]string{t.TempDir(), "profile.json"}, string(os.PathSeparator))
+	codeownersProfile := `{
+  "codeowners": [
+    {"pattern": "*", "owners": ["@acme/core", "@vigo"]},
+    {"pattern": "/docs/", "owners": ["@acme/docs", "docs@example.com"]}
+  ]
+}`
+	if err = os.WriteFile(codeownersProfileFile, []byte(codeownersProfile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidCodeownersProfileFile := strings.Join([]string{t.TempDir(), "profile.json"}, string(os.PathSeparator))
+	invalidCodeownersProfile := `{"codeowners": [{"pattern": "*", "owners": ["@-vigo"]}]}`
+	if err = os.WriteFile(invalidCodeownersProfileFile, []byte(invalidCodeownersProfile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	fundingProfileFile := strings.Join([]string{t.TempDir(), "profile.json"}, string(os.PathSeparator))
+	fundingProfile := `{"funding": {"github": "vigo", "ko_fi": ["vigo"]}}`
+	if err = os.WriteFile(fundingProfileFile, []byte(fundingProfile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	pgpKeyFile := strings.Join([]string{t.TempDir(), "key.asc"}, string(os.PathSeparator))
+	if err = os.WriteFile(pgpKeyFile, []byte(testPGPPublicKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidPGPKeyFile := strings.Join([]string{t.TempDir(), "key.asc"}, string(os.PathSeparator))
+	invalidPGPKey := strings.Replace(testPGPPublicKey, "mDME", "XDME", 1)
+	if err = os.WriteFile(invalidPGPKeyFile, []byte(invalidPGPKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	pullRequestProfileFile := strings.Join([]string{t.TempDir(), "profile.json"}, string(os.PathSeparator))
+	pullRequestProfile := `{"pull_request_templates": ["release"], "require_issue_link": true}`
+	if err = os.WriteFile(pullRequestProfileFile, []byte(pullRequestProfile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tempDir := os.TempDir()
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("Failed to change directory to temp: %v", err)
+	}
+
+	defer func() {
+		if err := os.Chdir(originalDir); err != nil {
+			t.Fatalf("Failed to restore original directory: %v", err)
+		}
+	}()
+
+	testCases := []struct {
+		name          string
+		input         []string
+		folder        string
+		lookupInFiles map[string][]string
+		missingFiles  []string
+		err           error
+	}{
+		{
+			name: "create with english and turkish",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--lang", "en,tr",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"## Requirements",
+					"[English](README.md) | [Türkçe](README.tr.md)",
+					"blob/main/CODE_OF_CONDUCT.md",
+				},
+				"README.tr.md": {
+					"## Gereksinimler",
+					"[English](README.md) | [Türkçe](README.tr.md)",
+					"blob/main/CODE_OF_CONDUCT.tr.md",
+				},
+				"CODE_OF_CONDUCT.tr.md":     {"# Katılımcı Sözleşmesi Davranış Kuralları"},
+				"CONTRIBUTING.md":           {"[code of conduct](CODE_OF_CONDUCT.md)"},
+				".git-init-githubrepo.json": {`"en"`, `"tr"`},
+			},
+		},
+		{
+			name: "create with turkish only",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--lang", "tr",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md":          {"## Lisans"},
+				"CODE_OF_CONDUCT.md": {"## Taahhüdümüz"},
+			},
+			missingFiles: []string{"README.tr.md", "CODE_OF_CONDUCT.tr.md"},
+		},
+		{
+			name: "create with untranslated coc",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--lang", "en,tr",
+				"--coc", "cc-14",
+			},
+			lookupInFiles: map[string][]string{
+				"README.tr.md": {"blob/main/CODE_OF_CONDUCT.md"},
+			},
+			missingFiles: []string{"CODE_OF_CONDUCT.tr.md"},
+		},
+		{
+			name: "create with localized pull request and issue templates",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--lang", "tr,en",
+				"--pull-request-template", "bugfix",
+			},
+			lookupInFiles: map[string][]string{
+				".github/pull_request_template.md":        {"## Açıklama\n", "## Kontrol Listesi\n", "Closes #"},
+				".github/PULL_REQUEST_TEMPLATE/bugfix.md": {"## Kök Neden\n", "Fixes #"},
+				".github/ISSUE_TEMPLATE/bug_report.yml":   {`name: "Hata Bildirimi"`, `title: "[Hata]: "`},
+				".github/ISSUE_TEMPLATE/config.yml":       {`name: "Tartışmalar"`},
+			},
+		},
+		{
+			name: "create with go project style",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-version", "1.25.0",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"![Version](https://img.shields.io/badge/version-0.0.0-orange.svg)",
+					"[![License](https://img.shields.io/github/license/vigo/repo)](LICENSE)",
+					"https://github.com/vigo/repo/actions/workflows/go-test.yml/badge.svg",
+					"https://github.com/vigo/repo/actions/workflows/go-lint.yml/badge.svg",
+					"https://goreportcard.com/badge/github.com/vigo/repo",
+					"https://pkg.go.dev/badge/github.com/vigo/repo.svg",
+					"https://img.shields.io/github/go-mod/go-version/vigo/repo",
+					"## Installation\n\n```bash\ngo install github.com/vigo/repo/cmd/repo@latest\n```",
+					"Usage information here...\n\n---\n\n## Commands\n\n",
+					"```bash\nrepo            # run\nrepo -version   # display version",
+				},
+				"go.mod":                        {"module github.com/vigo/repo\n\ngo 1.25.0\n"},
+				"cmd/repo/main.go":              {`"github.com/vigo/repo/internal/version"`, `flags.Bool("version"`},
+				"cmd/repo/main_test.go":         {"func TestRun(t *testing.T)"},
+				".github/workflows/go-test.yml": {"${{ secrets.CODECOV_TOKEN }}", "slug: vigo/repo"},
+				".github/workflows/go-lint.yml": {"golangci-lint-action"},
+				".github/dependabot.yml":        {`"gomod"`},
+				".golangci.yml":                 {"linters:"},
+				".gitignore":                    {"/repo"},
+				".git-init-githubrepo.json":     {`"project_style": "go"`, `"go_layout": "cli"`},
+			},
+		},
+		{
+			name: "create with python project style",
+			input: []string{
+				"--full-name", "Uğur Özyılmazel",
+				"--email", "vigo@example.com",
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "python",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"https://github.com/vigo/repo/actions/workflows/python-test.yml/badge.svg",
+					"https://img.shields.io/python/required-version-toml?tomlFilePath=" +
+						"https%3A%2F%2Fraw.githubusercontent.com%2Fvigo%2Frepo%2Fmain%2Fpyproject.toml",
+					"## Installation\n\n```bash\npip install git+https://github.com/vigo/repo.git\n```",
+				},
+				"pyproject.toml": {
+					"name = \"repo\"\nversion = \"0.0.0\"\n",
+					"license = \"MIT\"\nlicense-files = [\"LICENSE*\"]\n",
+					"{ name = 'Uğur Özyılmazel', email = 'vigo@example.com' },",
+					"packages = [\"src/repo\"]",
+				},
+				"src/repo/__init__.py": {`__version__ = version("repo")`},
+				"tests/test_repo.py":   {"from repo import __version__, greet"},
+				".github/workflows/python-test.yml": {
+					`python-version: ["3.11", "3.12", "3.13", "3.14"]`,
+					"if: matrix.python-version == '3.14'",
+				},
+				".github/dependabot.yml": {`"pip"`},
+				".gitignore":             {"__pycache__/"},
+				".bumpversion.toml":      {"filename = \"pyproject.toml\"\nsearch = 'version = \"{current_version}\"'"},
+				"CONTRIBUTING.md":        {"pytest"},
+			},
+		},
+		{
+			name: "create with rust project style",
+			input: []string{
+				"--full-name", "Uğur Özyılmazel",
+				"--email", "vigo@example.com",
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "rust",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"https://github.com/vigo/repo/actions/workflows/rust-ci.yml/badge.svg",
+					"[![License: MIT](https://img.shields.io/badge/license-MIT-blue.svg)](LICENSE-MIT)\n" +
+						"[![License: Apache-2.0](https://img.shields.io/badge/license-Apache--2.0-blue.svg)](LICENSE-APACHE)",
+					"This project is licensed under [MIT](LICENSE-MIT) or [Apache-2.0](LICENSE-APACHE), at your option.",
+					"## Installation\n\n```bash\ncargo install --git https://github.com/vigo/repo\n```",
+				},
+				"Cargo.toml": {
+					"name = \"repo\"\nversion = \"0.0.0\"\nedition = \"2024\"\nrust-version = \"1.85\"\n",
+					`authors = ['Uğur Özyılmazel <vigo@example.com>']`,
+					`license = "MIT OR Apache-2.0"`,
+				},
+				"LICENSE-MIT":                   {"MIT License", "Uğur Özyılmazel"},
+				"LICENSE-APACHE":                {"Apache License"},
+				"src/main.rs":                   {`greeting("repo")`},
+				"deny.toml":                     {"  \"Apache-2.0\",\n  \"BSD-2-Clause\","},
+				".github/workflows/rust-ci.yml": {`toolchain: ["stable", "1.85"]`, "toolchain: ${{ matrix.toolchain }}"},
+				".github/dependabot.yml":        {`"cargo"`},
+				".gitignore":                    {"/target"},
+				".bumpversion.toml":             {"filename = \"Cargo.toml\"\nsearch = 'version = \"{current_version}\"'"},
+				"CONTRIBUTING.md":               {"cargo clippy --all-targets -- -D warnings"},
+			},
+			missingFiles: []string{"LICENSE", "src/lib.rs"},
+		},
+		{
+			name: "create with rust library crate",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "rust",
+				"--rust-crate-type", "lib",
+				"--license", "moz-p20",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md":  {"cargo add repo --git https://github.com/vigo/repo"},
+				"Cargo.toml": {`name = "repo"`, `license = "MPL-2.0"`},
+				"src/lib.rs": {`assert_eq!(repo::greet("Rust"), "Hello, Rust!");`},
+				"deny.toml":  {`"MPL-2.0",`},
+				"LICENSE":    {"Mozilla Public License"},
+			},
+			missingFiles: []string{"src/main.rs", "LICENSE-MIT"},
+		},
+		{
+			name: "create with invalid rust crate type",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "rust",
+				"--rust-crate-type", "cdylib",
+			},
+			err: command.ErrInvalidRustCrateType,
+		},
+		{
+			name: "create with node project style",
+			input: []string{
+				"--full-name", "Uğur Özyılmazel",
+				"--email", "vigo@example.com",
+				"--username", "vigo",
+				"--project-name", `My "Node" App`,
+				"--repository-name", "repo",
+				"--project-style", "node",
+				"--codemeta",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"https://github.com/vigo/repo/actions/workflows/node-ci.yml/badge.svg",
+					"[![npm](https://img.shields.io/npm/v/repo)](https://www.npmjs.com/package/repo)",
+					"## Installation\n\n```bash\nnpm install repo\n```",
+				},
+				"package.json": {
+					`"name": "repo",`,
+					`"description": "My \"Node\" App",`,
+					`"author": "Uğur Özyılmazel <vigo@example.com>",`,
+					`"license": "MIT",`,
+					`"url": "git+https://github.com/vigo/repo.git"`,
+					`"url": "https://github.com/vigo/repo/issues"`,
+					`"homepage": "https://github.com/vigo/repo#readme",`,
+					`".": "./src/index.js"`,
+				},
+				"eslint.config.js":   {"js.configs.recommended,\n  { languageOptions"},
+				"src/index.js":       {"export function greet(name) {"},
+				"test/index.test.js": {`import { greet } from "../src/index.js";`},
+				".nvmrc":             {"24\n"},
+				".prettierrc.json":   {`"printWidth": 100`},
+				".github/workflows/node-ci.yml": {
+					`node-version: ["22", "24"]`,
+					"node-version: ${{ matrix.node-version }}\n      - run: npm install\n",
+				},
+				".github/workflows/npm-publish.yml": {"- run: npm install\n", "npm publish --provenance --access public"},
+				".github/dependabot.yml":            {`package-ecosystem: "npm"`},
+				".gitignore":                        {"node_modules/"},
+				".bumpversion.toml":                 {"filename = \"package.json\"\nsearch = '\"version\": \"{current_version}\"'"},
+				"codemeta.json":                     {`"programmingLanguage": "JavaScript"`},
+			},
+			missingFiles: []string{"tsconfig.json", "src/index.ts"},
+		},
+		{
+			name: "create with node typescript variant",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node",
+				"--ts",
+				"--codemeta",
+			},
+			lookupInFiles: map[string][]string{
+				"package.json": {
+					`"types": "./dist/index.d.ts",`,
+					`"build": "tsc",`,
+					`"prepublishOnly": "npm run build"`,
+					`"typescript-eslint": `,
+				},
+				"tsconfig.json":                 {`"outDir": "dist"`},
+				"eslint.config.js":              {`import tseslint from "typescript-eslint";`, "tseslint.configs.recommended,"},
+				"src/index.ts":                  {"export function greet(name: string): string {"},
+				"test/index.test.ts":            {`import { greet } from "../src/index.ts";`},
+				".github/workflows/node-ci.yml": {"- run: npm run build"},
+				"codemeta.json":                 {`"programmingLanguage": "TypeScript"`},
+				".git-init-githubrepo.json":     {`"typescript": true`},
+			},
+			missingFiles: []string{"src/index.js"},
+		},
+		{
+			name: "create with composed project styles",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go:backend,python:api",
+				"--project-style", "node:web",
+				"--go-version", "1.25",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"## Installation\n\n### Go (`backend/`)\n\n```bash\ngo install github.com/vigo/repo/backend/cmd/repo@latest\n```" +
+						"\n\n### Python (`api/`)\n\n```bash\npip install git+https://github.com/vigo/repo.git#subdirectory=api\n```" +
+						"\n\n### Node.js (`web/`)\n\n```bash\nnpm install repo\n```",
+					"![Go Version](https://img.shields.io/github/go-mod/go-version/vigo/repo?filename=backend%2Fgo.mod)",
+				},
+				"backend/go.mod":           {"module github.com/vigo/repo/backend\n"},
+				"backend/cmd/repo/main.go": {"package main"},
+				"api/pyproject.toml":       {`name = "repo"`, "readme = \"README.md\"\n", "license = \"MIT\"\nauthors = ["},
+				"api/README.md":            {"[README](https://github.com/vigo/repo#readme)"},
+				"web/package.json":         {`"name": "repo",`},
+				".gitignore": {
+					"# binaries\n*.exe\n*.exe~\n*.dll\n*.so\n*.dylib\n/backend/repo\n",
+					"# environment files\n.env\n\n",
+					"# dependencies\nnode_modules/\n",
+				},
+				".github/dependabot.yml": {
+					"  - package-ecosystem: \"github-actions\"\n    directory: \"/\"\n",
+					"  - package-ecosystem: \"gomod\"\n    directory: \"/backend\"\n",
+					"  - package-ecosystem: \"pip\"\n    directory: \"/api\"\n",
+					"  - package-ecosystem: \"npm\"\n    directory: \"/web\"\n",
+				},
+				".pre-commit-config.yaml": {
+					"      - id: go-mod-tidy\n        files: ^backend/\n",
+					"      - id: ruff-format\n        files: ^api/\n",
+				},
+				".github/workflows/go-test.yml": {
+					"      - 'backend/**.go'",
+					"defaults:\n  run:\n    working-directory: backend\n",
+					`go-version-file: "backend/go.mod"`,
+				},
+				".github/workflows/node-ci.yml": {`node-version-file: "web/.nvmrc"`},
+				".bumpversion.toml": {
+					"filename = \"backend/internal/version/version.go\"",
+					"filename = \"api/pyproject.toml\"",
+					"filename = \"web/package.json\"",
+				},
+				"CONTRIBUTING.md":           {"- run tests in `backend/`:", "- install pre-commit hooks:"},
+				".git-init-githubrepo.json": {`"project_style": "go:backend,python:api,node:web"`},
+			},
+			missingFiles: []string{"go.mod", "backend/.gitignore", "web/.github/dependabot.yml"},
+		},
+		{
+			name: "create with invalid project style root",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go:../backend",
+			},
+			err: command.ErrInvalidStyleRoot,
+		},
+		{
+			name: "create with duplicate project style",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node,node:web",
+			},
+			err: command.ErrDuplicateProjectStyle,
+		},
+		{
+			name: "create with docker style layered on go service",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go,docker",
+				"--go-layout", "service",
+				"--go-version", "1.25",
+			},
+			lookupInFiles: map[string][]string{
+				"Dockerfile": {
+					"FROM --platform=$BUILDPLATFORM golang:1.25 AS build\n",
+					"# syntax=docker/dockerfile:1\n\nARG VERSION=0.0.0\n\n",
+					"ARG TARGETARCH\nARG VERSION\n",
+					"go build -trimpath -ldflags=\"-s -w -X github.com/vigo/repo/internal/version.Version=${VERSION}\" " +
+						"-o /out/repo ./cmd/repo\n",
+					"FROM gcr.io/distroless/static-debian12:nonroot\n",
+					"      org.opencontainers.image.source=\"https://github.com/vigo/repo\" \\\n",
+					"      org.opencontainers.image.licenses=\"MIT\"\n",
+					"EXPOSE 8080\n",
+				},
+				"internal/version/version.go": {`var Version = "0.0.0"`},
+				".dockerignore":               {"# go build and test output\n/repo\n"},
+				"compose.yaml":                {"services:\n  repo:\n", "image: ghcr.io/vigo/repo:local\n", `- "8080:8080"`},
+				".github/workflows/docker-publish.yml": {
+					"platforms: linux/amd64,linux/arm64\n",
+					"images: ghcr.io/vigo/repo\n",
+					"org.opencontainers.image.licenses=MIT\n",
+					"VERSION=${{ steps.meta.outputs.version }}\n",
+				},
+				".github/dependabot.yml": {"  - package-ecosystem: \"docker\"\n    directory: \"/\"\n"},
+				"README.md": {
+					"### Docker\n\n```bash\ndocker pull ghcr.io/vigo/repo:latest\n```",
+				},
+				".git-init-githubrepo.json": {`"project_style": "go,docker"`},
+			},
+		},
+		{
+			name: "create with docker style layered on node under root",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node:web,docker",
+				"--ts",
+				"--versioning", "release-please",
+			},
+			lookupInFiles: map[string][]string{
+				"web/Dockerfile": {
+					"FROM node:24-slim AS build\n",
+					"RUN npm run build\n",
+					`CMD ["node", "dist/index.js"]`,
+				},
+				"web/.dockerignore":                    {"node_modules/\n"},
+				"web/compose.yaml":                     {"services:\n  repo:\n"},
+				".github/workflows/docker-publish.yml": {"context: web\n"},
+				"release-please-config.json":           {`"release-type": "node"`},
+				".git-init-githubrepo.json":            {`"project_style": "node:web,docker:web"`},
+			},
+			missingFiles: []string{"Dockerfile", "web/.github/workflows/docker-publish.yml"},
+		},
+		{
+			name: "create with stand-alone docker style",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "docker",
+				"--disable-license",
+			},
+			lookupInFiles: map[string][]string{
+				"Dockerfile": {"FROM alpine:3.22\n", "org.opencontainers.image.version=$VERSION\n\nWORKDIR /app\n"},
+			},
+		},
+		{
+			name: "create with docker style layered on go library",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go,docker",
+				"--go-layout", "library",
+			},
+			err: command.ErrDockerRequiresExecutable,
+		},
+		{
+			name: "create with composite action style",
+			input: []string{
+				"--username", "vigo",
+				"--full-name", "Uğur Özyılmazel",
+				"--project-name", "Greeter",
+				"--repository-name", "repo",
+				"--project-style", "action",
+			},
+			lookupInFiles: map[string][]string{
+				"action.yml": {
+					"name: \"Greeter\"\n",
+					"author: \"Uğur Özyılmazel\"\n",
+					"branding:\n  icon: \"check-circle\"\n  color: \"blue\"\n",
+					"    value: ${{ steps.greet.outputs.greeting }}\n",
+					"  using: \"composite\"\n",
+				},
+				"README.md": {
+					"## Usage\n\n```yaml\n- uses: vigo/repo@v1\n",
+					"[![action test](https://github.com/vigo/repo/actions/workflows/action-test.yml/badge.svg)]",
+				},
+				".github/workflows/action-test.yml":    {"      - id: action\n        uses: ./\n"},
+				".github/workflows/action-release.yml": {`major="${GITHUB_REF_NAME%%.*}"`},
+				".git-init-githubrepo.json":            {`"action_type": "composite"`},
+			},
+			missingFiles: []string{"Dockerfile", "package.json", ".gitignore"},
+		},
+		{
+			name: "create with docker action style",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "action",
+				"--action-type", "docker",
+			},
+			lookupInFiles: map[string][]string{
+				"action.yml":    {"  using: \"docker\"\n  image: \"Dockerfile\"\n"},
+				"Dockerfile":    {`ENTRYPOINT ["sh", "/entrypoint.sh"]`},
+				"entrypoint.sh": {`echo "greeting=${greeting}" >> "${GITHUB_OUTPUT}"`},
+			},
+		},
+		{
+			name: "create with node action style under root",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node,action:actions/greet",
+				"--action-type", "node",
+			},
+			lookupInFiles: map[string][]string{
+				"actions/greet/action.yml":   {"  using: \"node24\"\n  main: \"dist/index.js\"\n"},
+				"actions/greet/package.json": {`"build": "ncc build src/index.js --out dist --license licenses.txt"`},
+				"actions/greet/src/index.js": {`import { greet } from "./greet.js";`},
+				"README.md":                  {"- uses: vigo/repo/actions/greet@v1\n"},
+				".gitignore":                 {"# build output\ndist/\n", "!/actions/greet/dist/"},
+				".github/workflows/action-test.yml": {
+					"        working-directory: actions/greet\n",
+					"        run: |\n          npm install\n          npm test\n",
+					"        uses: ./actions/greet\n",
+				},
+				".github/dependabot.yml": {"  - package-ecosystem: \"github-actions\"\n    directory: \"/actions/greet\"\n"},
+			},
+		},
+		{
+			name: "create with invalid action type",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "action",
+				"--action-type", "javascript",
+			},
+			err: command.ErrInvalidActionType,
+		},
+		{
+			name: "create with node action sharing root with node style",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node,action",
+				"--action-type", "node",
+			},
+			err: command.ErrActionNodeStyle,
+		},
+		{
+			name: "create with terraform module style",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "terraform-aws-vpc",
+				"--project-style", "terraform",
+				"--versioning", "release-please",
+			},
+			folder: "terraform-aws-vpc",
+			lookupInFiles: map[string][]string{
+				"versions.tf": {
+					"  required_version = \">= 1.9\"\n",
+					"    aws = {\n      source  = \"hashicorp/aws\"\n",
+				},
+				"main.tf":                    {`resource "terraform_data" "this" {`},
+				"variables.tf":               {`variable "name" {`, `variable "tags" {`},
+				"outputs.tf":                 {"value       = terraform_data.this.output.name\n"},
+				"examples/basic/main.tf":     {"module \"vpc\" {\n  source = \"../..\"\n"},
+				"examples/basic/versions.tf": {"required_version"},
+				".tflint.hcl":                {`preset  = "recommended"`},
+				".terraform-docs.yml":        {"  file: \"README.md\"\n  mode: inject\n", "    {{ .Content }}\n"},
+				".pre-commit-config.yaml": {
+					"      - id: terraform_fmt\n      - id: terraform_validate\n      - id: terraform_docs\n",
+				},
+				".github/workflows/terraform-ci.yml": {
+					"terraform fmt -check -recursive",
+					"terraform-docs/gh-actions@v1",
+					"fail-on-diff: ${{ github.event_name == 'pull_request' }}\n",
+					"git-push: ${{ github.event_name == 'push' }}\n",
+				},
+				".github/dependabot.yml": {"  - package-ecosystem: \"terraform\"\n"},
+				".gitignore":             {".terraform/\n", ".terraform.lock.hcl\n"},
+				"README.md": {
+					"## Usage\n\n```hcl\nmodule \"vpc\" {\n  source = \"vigo/vpc/aws\"\n",
+					"<!-- BEGIN_TF_DOCS -->\n<!-- END_TF_DOCS -->",
+				},
+				"release-please-config.json": {`"release-type": "terraform-module"`},
+			},
+		},
+		{
+			name: "create with terraform module style under root",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "terraform-google-network",
+				"--project-style", "terraform:modules/network",
+			},
+			folder: "terraform-google-network",
+			lookupInFiles: map[string][]string{
+				"modules/network/.terraform-docs.yml": {"  file: \"../../README.md\"\n"},
+				"README.md": {
+					"  source = \"github.com/vigo/terraform-google-network//modules/network\"\n",
+				},
+				".github/workflows/terraform-ci.yml": {"working-directory: modules/network\n"},
+			},
+		},
+		{
+			name: "create with terraform module style and invalid repository name",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "terraform",
+			},
+			err: command.ErrInvalidTerraformModuleName,
+		},
+		{
+			name: "create with helm style",
+			input: []string{
+				"--username", "vigo",
+				"--full-name", "Uğur Özyılmazel",
+				"--email", "ugur@example.com",
+				"--project-name", "test",
+				"--repository-name", "My_Chart",
+				"--project-style", "helm",
+			},
+			folder: "My_Chart",
+			lookupInFiles: map[string][]string{
+				"charts/my-chart/Chart.yaml": {
+					"name: my-chart\n",
+					"version: 0.0.0\nappVersion: \"0.0.0\"\n",
+					"  - name: \"Uğur Özyılmazel\"\n    email: ugur@example.com\n",
+				},
+				"charts/my-chart/values.yaml":                          {"  repository: ghcr.io/vigo/my_chart\n"},
+				"charts/my-chart/values.schema.json":                   {`"title": "my-chart"`},
+				"charts/my-chart/templates/_helpers.tpl":               {`{{- define "my-chart.fullname" -}}`},
+				"charts/my-chart/templates/deployment.yaml":            {`{{- include "my-chart.labels" . | nindent 4 }}`},
+				"charts/my-chart/templates/tests/test-connection.yaml": {`"helm.sh/hook": test`},
+				"ct.yaml":                            {"chart-dirs:\n  - charts\n"},
+				".github/workflows/helm-lint.yml":    {"ct lint --config ct.yaml --all"},
+				".github/workflows/helm-release.yml": {"helm/chart-releaser-action@v1", "charts_dir: charts\n"},
+				".bumpversion.toml": {
+					"filename = \"charts/my-chart/Chart.yaml\"\nsearch = 'version: {current_version}'\n",
+					"search = 'appVersion: \"{current_version}\"'\n",
+				},
+				"README.md": {"helm repo add vigo https://vigo.github.io/My_Chart\n"},
+			},
+		},
+		{
+			name: "create with helm style under root with release-please",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "helm:deploy",
+				"--versioning", "release-please",
+			},
+			lookupInFiles: map[string][]string{
+				"deploy/charts/repo/Chart.yaml":      {"version: 0.0.0 # x-release-please-version\n"},
+				"release-please-config.json":         {"\"extra-files\": [\n        \"deploy/charts/repo/Chart.yaml\"\n      ]"},
+				".github/workflows/helm-lint.yml":    {"working-directory: deploy\n"},
+				".github/workflows/helm-release.yml": {"charts_dir: deploy/charts\n"},
+			},
+		},
+		{
+			name: "create with go library layout",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-layout", "library",
+				"--go-module-host", "github.example.com",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"go get github.example.com/vigo/repo@latest",
+					"Usage information here...\n\n---\n\n## API\n\n",
+					"[pkg.go.dev](https://pkg.go.dev/github.example.com/vigo/repo)",
+					"fmt.Println(repo.Greet(\"World\"))",
+				},
+				"go.mod":          {"module github.example.com/vigo/repo\n\ngo 1."},
+				"repo.go":         {"package repo\n"},
+				"example_test.go": {`repo "github.example.com/vigo/repo"`, "// Output: Hello, Gopher!"},
+				".bumpversion.toml": {
+					"filename = \"README.md\"\n\n[[tool.bumpversion.files]]\nfilename = \"CHANGELOG.md\"\n",
+				},
+			},
+			missingFiles: []string{"cmd/repo/main.go", "internal/version/version.go"},
+		},
+		{
+			name: "create with go service layout",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-layout", "service",
+				"--go-module", "go.example.com/repo",
+				"--go-version", "1.25",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"Usage information here...\n\n---\n\n## Configuration\n\n",
+					"| `ADDR`   | `:8080` | listen address of the http server |\n\n---\n\n## Deployment\n\n",
+					"go build -o bin/repo ./cmd/repo\n",
+				},
+				"go.mod":                {"module go.example.com/repo\n\ngo 1.25\n"},
+				"cmd/repo/main.go":      {`mux.HandleFunc("/healthz"`},
+				"cmd/repo/main_test.go": {"func TestHealthz(t *testing.T)"},
+			},
+		},
+		{
+			name: "create with invalid go layout",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-layout", "monorepo",
+			},
+			err: command.ErrInvalidGoLayout,
+		},
+		{
+			name: "create with invalid go version",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-version", "go1.25",
+			},
+			err: command.ErrInvalidGoVersion,
+		},
+		{
+			name: "create with invalid go module",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-module", "repo",
+			},
+			err: command.ErrInvalidGoModule,
+		},
+		{
+			name: "create with badges from profile and flags",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--profile", profileFile,
+				"--disable-badge", "version",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {"[![OpenSSF Scorecard](https://api.scorecard.dev/projects/github.com/"},
+			},
+		},
+		{
+			name: "create with scorecard badge of composed go style",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go:backend",
+				"--badge", "scorecard",
+				"--badge", "pkggodev",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"[![OpenSSF Scorecard](https://api.scorecard.dev/projects/github.com/vigo/repo/badge)]" +
+						"(https://scorecard.dev/viewer/?uri=github.com/vigo/repo)",
+					"[![Go Reference](https://pkg.go.dev/badge/github.com/vigo/repo/backend.svg)]",
+				},
+			},
+		},
+		{
+			name: "create with initial version",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--lang", "en,tr",
+				"--initial-version", "1.2.3",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md":    {"![Version](https://img.shields.io/badge/version-1.2.3-orange.svg)"},
+				"README.tr.md": {"![Version](https://img.shields.io/badge/version-1.2.3-orange.svg)"},
+				".bumpversion.toml": {
+					`current_version = "1.2.3"`,
+					"[[tool.bumpversion.files]]\nfilename = \"README.md\"\n",
+					"[[tool.bumpversion.files]]\nfilename = \"README.tr.md\"\n",
+					"[[tool.bumpversion.files]]\nfilename = \"internal/version/version.go\"\n" +
+						"search = '= \"{current_version}\"'",
+				},
+				"internal/version/version.go": {`const Version string = "1.2.3"`},
+			},
+		},
+		{
+			name: "create with invalid initial version",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--initial-version", "v1",
+			},
+			err: command.ErrInvalidVersion,
+		},
+		{
+			name: "create with contributing and support",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--versioning", "release-please",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {"Please read [CONTRIBUTING.md](CONTRIBUTING.md) before opening a **Pull Request**."},
+				"CONTRIBUTING.md": {
+					"1. `fork` (https://github.com/vigo/repo/fork)",
+					"```bash\ngo test -race ./...\n```",
+					"[Conventional Commits](https://www.conventionalcommits.org/en/v1.0.0/)",
+					"git commit -s -m 'add some functionality'",
+					"[code of conduct](CODE_OF_CONDUCT.md)",
+				},
+				"SUPPORT.md": {
+					"[existing issues](https://github.com/vigo/repo/issues)",
+					"- output of `go version`",
+					"Please read [CONTRIBUTING.md](CONTRIBUTING.md)",
+				},
+			},
+		},
+		{
+			name: "create with contributor license agreement",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--contributor-agreement", "cla",
+				"--cla-url", "https://cla.example.com",
+			},
+			lookupInFiles: map[string][]string{
+				"CONTRIBUTING.md": {"## Contributor License Agreement", "https://cla.example.com"},
+			},
+		},
+		{
+			name: "create without contributing",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--disable-contributing",
+				"--disable-support",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {"1. `fork` (https://github.com/vigo/repo/fork)"},
+			},
+			missingFiles: []string{"CONTRIBUTING.md", "SUPPORT.md"},
+		},
+		{
+			name: "create with cla without url",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--contributor-agreement", "cla",
+			},
+			err: command.ErrCLAURLRequired,
+		},
+		{
+			name: "create with invalid contributor agreement",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--contributor-agreement", "notexist",
+			},
+			err: command.ErrInvalidContributorAgreement,
+		},
+		{
+			name: "create with issue forms",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+			},
+			lookupInFiles: map[string][]string{
+				".github/ISSUE_TEMPLATE/bug_report.yml": {
+					`labels: ["bug"]`,
+					"id: go-version",
+					"- \"macOS\"",
+				},
+				".github/ISSUE_TEMPLATE/feature_request.yml": {`labels: ["enhancement"]`},
+				".github/ISSUE_TEMPLATE/question.yml":        {`labels: ["question"]`},
+				".github/ISSUE_TEMPLATE/config.yml": {
+					"blank_issues_enabled: false",
+					"url: https://github.com/vigo/repo/discussions",
+					"url: https://github.com/vigo/repo/security/policy",
+				},
+			},
+		},
+		{
+			name: "create without issue forms",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--disable-issue-template",
+			},
+			missingFiles: []string{".github/ISSUE_TEMPLATE/bug_report.yml", ".github/ISSUE_TEMPLATE/config.yml"},
+		},
+		{
+			name: "create with codeowners",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+			},
+			lookupInFiles: map[string][]string{
+				".github/CODEOWNERS": {"\n* @vigo\n"},
+			},
+		},
+		{
+			name: "create with codeowners from profile",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--profile", codeownersProfileFile,
+			},
+			lookupInFiles: map[string][]string{
+				".github/CODEOWNERS": {"\n* @acme/core @vigo\n/docs/ @acme/docs docs@example.com\n"},
+			},
+		},
+		{
+			name: "create with invalid codeowners owner",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--profile", invalidCodeownersProfileFile,
+			},
+			err: command.ErrInvalidCodeownersOwner,
+		},
+		{
+			name: "create with funding",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--funding", "github=vigo",
+				"--funding", "github=octocat",
+				"--funding", "custom=https://paypal.me/vigo",
+				"--funding", "thanks_dev=u/gh/vigo",
+			},
+			lookupInFiles: map[string][]string{
+				".github/FUNDING.yml": {
+					"github: [vigo, octocat]\nthanks_dev: u/gh/vigo\ncustom: \"https://paypal.me/vigo\"\n",
+				},
+			},
+		},
+		{
+			name: "create with funding from profile",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--profile", fundingProfileFile,
+			},
+			lookupInFiles: map[string][]string{
+				".github/FUNDING.yml": {"github: vigo\nko_fi: vigo\n"},
+			},
+		},
+		{
+			name: "create without funding platform",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+			},
+			missingFiles: []string{".github/FUNDING.yml"},
+		},
+		{
+			name: "create with invalid funding platform",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--funding", "paypal=vigo",
+			},
+			err: command.ErrInvalidFundingPlatform,
+		},
+		{
+			name: "create with invalid funding value",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--funding", "custom=ftp://example.com",
+			},
+			err: command.ErrInvalidFundingValue,
+		},
+		{
+			name: "create with too many funding values",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--funding", "patreon=vigo",
+				"--funding", "patreon=octocat",
+			},
+			err: command.ErrInvalidFundingValue,
+		},
+		{
+			name: "create with security policy",
+			input: []string{
+				"--username", "vigo",
+				"--email", "security@example.com",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--initial-version", "1.4.2",
+				"--security-pgp-key", pgpKeyFile,
+				"--website", "https://example.com/",
+				"--lang", "en,tr",
+			},
+			lookupInFiles: map[string][]string{
+				"SECURITY.md": {
+					"| 1.x.x | :white_check_mark: |\n| < 1.0 | :x: |",
+					"- [GitHub private vulnerability reporting](https://github.com/vigo/repo/security/advisories/new)",
+					"- [security@example.com](mailto:security@example.com)",
+					"Fingerprint: `40DC 4CE8 BDC3 A9B0 7F16 F081 0DDE 7065 99EF C267`",
+					"-----BEGIN PGP PUBLIC KEY BLOCK-----",
+				},
+				".well-known/security.txt": {
+					"Contact: mailto:security@example.com\n",
+					"Contact: https://github.com/vigo/repo/security/advisories/new\n",
+					"Expires: ",
+					"Encryption: openpgp4fpr:40dc4ce8bdc3a9b07f16f0810dde706599efc267\n",
+					"Preferred-Languages: en, tr\n",
+					"Canonical: https://example.com/.well-known/security.txt\n",
+				},
+			},
+		},
+		{
+			name: "create with security contact url",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--initial-version", "0.3.0",
+				"--security-contact", "https://example.com/report",
+				"--disable-private-reporting",
+			},
+			lookupInFiles: map[string][]string{
+				"SECURITY.md": {
+					"| 0.3.x | :white_check_mark: |\n| < 0.3 | :x: |",
+					"- [https://example.com/report](https://example.com/report)",
+				},
+			},
+			missingFiles: []string{".well-known/security.txt"},
+		},
+		{
+			name: "create with invalid security contact",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--security-contact", "not-a-contact",
+			},
+			err: command.ErrInvalidSecurityContact,
+		},
+		{
+			name: "create with invalid pgp key",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--security-pgp-key", invalidPGPKeyFile,
+			},
+			err: command.ErrInvalidPGPKey,
+		},
+		{
+			name: "create with invalid website",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--website", "example.com",
+			},
+			err: command.ErrInvalidWebsite,
+		},
+		{
+			name: "create with citation",
+			input: []string{
+				"--full-name", "Uğur Özyılmazel",
+				"--email", "vigo@example.com",
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--license", "apache-20",
+				"--citation",
+				"--orcid", "0000-0002-1825-0097",
+				"--affiliation", "ACME Lab",
+			},
+			lookupInFiles: map[string][]string{
+				"CITATION.cff": {
+					"cff-version: 1.2.0\n",
+					"title: \"test\"\n",
+					"  - given-names: \"Uğur\"\n    family-names: \"Özyılmazel\"\n",
+					"    email: \"vigo@example.com\"\n",
+					"    affiliation: \"ACME Lab\"\n",
+					"    orcid: \"https://orcid.org/0000-0002-1825-0097\"\n",
+					"repository-code: \"https://github.com/vigo/repo\"\n",
+					"license: Apache-2.0\n",
+					"version: \"0.0.0\"\n",
+				},
+				".bumpversion.toml": {"filename = \"CITATION.cff\"\nsearch = 'version: \"{current_version}\"'"},
+			},
+			missingFiles: []string{"codemeta.json"},
+		},
+		{
+			name: "create with codemeta",
+			input: []string{
+				"--full-name", "Uğur Özyılmazel",
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--disable-license",
+				"--codemeta",
+				"--orcid", "https://orcid.org/0000-0002-1825-0097",
+			},
+			lookupInFiles: map[string][]string{
+				"codemeta.json": {
+					`"@context": "https://w3id.org/codemeta/3.0"`,
+					`"@type": "SoftwareSourceCode"`,
+					`"codeRepository": "https://github.com/vigo/repo"`,
+					`"programmingLanguage": "Go"`,
+					`"@id": "https://orcid.org/0000-0002-1825-0097"`,
+				},
+			},
+			missingFiles: []string{"CITATION.cff"},
+		},
+		{
+			name: "create with invalid orcid",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--citation",
+				"--orcid", "0000-0002-1825-0098",
+			},
+			err: command.ErrInvalidORCID,
+		},
+		{
+			name: "create with citation without full name",
+			input: []string{
+				"--full-name", "",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--citation",
+			},
+			err: command.ErrCitationAuthorRequired,
+		},
+		{
+			name: "create with pull request template",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+			},
+			lookupInFiles: map[string][]string{
+				".github/pull_request_template.md": {
+					"<!-- Optional: link the issue this pull request resolves. -->\nCloses #\n",
+					"- [ ] `go test -race ./...` passes\n- [ ] `golangci-lint run` reports no issues\n",
+					"- [ ] I have added an entry under `Unreleased` in `CHANGELOG.md`",
+				},
+			},
+			missingFiles: []string{".github/PULL_REQUEST_TEMPLATE/feature.md", ".github/workflows/pr-issue-link.yml"},
+		},
+		{
+			name: "create with pull request template variants",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--pull-request-template", "feature",
+				"--pull-request-template", "bugfix",
+				"--require-issue-link",
+			},
+			lookupInFiles: map[string][]string{
+				".github/pull_request_template.md":         {"- [ ] This pull request is linked to an issue"},
+				".github/PULL_REQUEST_TEMPLATE/feature.md": {"## Motivation", "Closes #"},
+				".github/PULL_REQUEST_TEMPLATE/bugfix.md":  {"## Root Cause", "Fixes #", "- [ ] I have added a regression test"},
+				".github/workflows/pr-issue-link.yml":      {"PR_BODY: ${{ github.event.pull_request.body }}"},
+			},
+			missingFiles: []string{".github/PULL_REQUEST_TEMPLATE/release.md"},
+		},
+		{
+			name: "create with pull request templates from profile",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "release-please",
+				"--profile", pullRequestProfileFile,
+			},
+			lookupInFiles: map[string][]string{
+				".github/PULL_REQUEST_TEMPLATE/release.md": {"- [ ] This is the release pull request opened by release-please"},
+				".github/pull_request_template.md":         {"- [ ] Pull request title follows Conventional Commits"},
+				".github/workflows/pr-issue-link.yml":      {"Closes #123"},
+			},
+		},
+		{
+			name: "create with invalid pull request template",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--pull-request-template", "notexist",
+			},
+			err: command.ErrInvalidPullRequestTemplate,
+		},
+		{
+			name: "create with changelog",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--initial-version", "1.2.0",
+			},
+			lookupInFiles: map[string][]string{
+				"CHANGELOG.md": {
+					"## [Unreleased]",
+					"[Unreleased]: https://github.com/vigo/repo/commits/HEAD",
+				},
+				".bumpversion.toml": {
+					"filename = \"CHANGELOG.md\"\n" +
+						"search = '## [Unreleased]'\n" +
+						`replace = "## [Unreleased]\n\n## [{new_version}] - {now:%Y-%m-%d}"`,
+					"search = '[Unreleased]: https://github.com/vigo/repo/commits/HEAD'\n" +
+						`replace = "[Unreleased]: https://github.com/vigo/repo/compare/v{new_version}...HEAD\n` +
+						`[{new_version}]: https://github.com/vigo/repo/releases/tag/v{new_version}"` +
+						"\nignore_missing_version = true\n",
+					"search = '[Unreleased]: https://github.com/vigo/repo/compare/v{current_version}...HEAD'",
+				},
+			},
+		},
+		{
+			name: "create without changelog",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--disable-changelog",
+			},
+			missingFiles: []string{"CHANGELOG.md"},
+		},
+		{
+			name: "create with changelog without bumpversion",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "calver",
+				"--disable-bumpversion",
+			},
+			lookupInFiles: map[string][]string{
+				"CHANGELOG.md": {"[Calendar Versioning](https://calver.org/)"},
+			},
+			missingFiles: []string{".bumpversion.toml"},
+		},
+		{
+			name: "create with calver versioning",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "calver",
+				"--initial-version", "2025.1.0",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {"![Version](https://img.shields.io/badge/version-2025.1.0-orange.svg)"},
+				".bumpversion.toml": {
+					`current_version = "2025.1.0"`,
+					`serialize = ["{release}.{patch}"]`,
+					`calver_format = "{YYYY}.{MM}"`,
+				},
+			},
+		},
+		{
+			name: "create with semver prerelease versioning",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "semver-pre",
+				"--initial-version", "1.0.0-rc.1+build.7",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {"https://img.shields.io/github/v/tag/vigo/repo?sort=semver&include_prereleases"},
+				".bumpversion.toml": {
+					`current_version = "1.0.0-rc.1+build.7"`,
+					`values = ["alpha", "beta", "rc", "final"]`,
+				},
+			},
+		},
+		{
+			name: "create with release-please versioning",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--versioning", "release-please",
+				"--initial-version", "0.1.0",
+			},
+			lookupInFiles: map[string][]string{
+				"release-please-config.json":           {`"release-type": "go"`, `"internal/version/version.go"`},
+				".release-please-manifest.json":        {`".": "0.1.0"`},
+				".github/workflows/release-please.yml": {"googleapis/release-please-action"},
+				"internal/version/version.go":          {`"0.1.0" // x-release-please-version`},
+			},
+			missingFiles: []string{".bumpversion.toml"},
+		},
+		{
+			name: "create with semantic-release versioning",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node",
+				"--versioning", "semantic-release",
+			},
+			lookupInFiles: map[string][]string{
+				".releaserc.json":               {"@semantic-release/github"},
+				".github/workflows/release.yml": {"npx semantic-release"},
+			},
+			missingFiles: []string{".bumpversion.toml"},
+		},
+		{
+			name: "create with changesets versioning",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "node",
+				"--versioning", "changesets",
+			},
+			lookupInFiles: map[string][]string{
+				".changeset/config.json":        {`"repo": "vigo/repo"`},
+				".changeset/README.md":          {"npx changeset"},
+				".github/workflows/release.yml": {"changesets/action"},
+			},
+			missingFiles: []string{".bumpversion.toml"},
+		},
+		{
+			name: "create with goreleaser versioning",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--versioning", "goreleaser",
+			},
+			lookupInFiles: map[string][]string{
+				".goreleaser.yaml":              {"project_name: repo", "main: ./cmd/repo"},
+				".github/workflows/release.yml": {"goreleaser/goreleaser-action"},
+			},
+			missingFiles: []string{".bumpversion.toml"},
+		},
+		{
+			name: "create with go release bundle",
+			input: []string{
+				"--username", "vigo",
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-release",
+				"--homebrew-tap", "vigo/homebrew-tap",
+			},
+			lookupInFiles: map[string][]string{
+				".goreleaser.yaml": {
+					"-s -w -X github.com/vigo/repo/internal/version.Version={{ .Version }}",
+					"sboms:\n  - artifacts: archive",
+					"homebrew_casks:\n  - name: repo\n    repository:\n      owner: vigo\n      name: homebrew-tap",
+					"license: MIT",
+				},
+				".github/workflows/release.yml": {
+					"anchore/sbom-action/download-syft",
+					"HOMEBREW_TAP_GITHUB_TOKEN: ${{ secrets.HOMEBREW_TAP_GITHUB_TOKEN }}",
+				},
+				"internal/version/version.go": {`var Version = "0.0.0"`},
+				"README.md":                   {"brew install --cask vigo/tap/repo"},
+				".bumpversion.toml":           {"search = '= \"{current_version}\"'"},
+			},
+		},
+		{
+			name: "create with go release bundle for library",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-layout", "library",
+				"--versioning", "goreleaser",
+			},
+			err: command.ErrGoReleaseLayout,
+		},
+		{
+			name: "create with go release bundle and release-please",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--versioning", "release-please",
+				"--go-release",
+			},
+			err: command.ErrGoReleaseVersioning,
+		},
+		{
+			name: "create with invalid homebrew tap",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go",
+				"--go-release",
+				"--homebrew-tap", "tap",
+			},
+			err: command.ErrInvalidHomebrewTap,
+		},
+		{
+			name: "create with semantic-release versioning without node style",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "python",
+				"--versioning", "semantic-release",
+			},
+			err: command.ErrVersioningRequiresStyle,
+		},
+		{
+			name: "create with changesets versioning with node style under root",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "go,node:web",
+				"--versioning", "changesets",
+			},
+			err: command.ErrVersioningRequiresStyle,
+		},
+		{
+			name: "create with semver-pre versioning and leading zero prerelease",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "semver-pre",
+				"--initial-version", "1.0.0-rc.01",
+			},
+			err: command.ErrInvalidVersion,
+		},
+		{
+			name: "create with semver-pre versioning and without number prerelease",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "semver-pre",
+				"--initial-version", "1.0.0-alpha",
+			},
+			err: command.ErrInvalidVersion,
+		},
+		{
+			name: "create with semver-pre versioning and unknown label prerelease",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "semver-pre",
+				"--initial-version", "1.0.0-dev.1",
+			},
+			err: command.ErrInvalidVersion,
+		},
+		{
+			name: "create with semver-pre versioning and numeric identifiers prerelease",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "semver-pre",
+				"--initial-version", "1.0.0-0.3.7",
+			},
+			err: command.ErrInvalidVersion,
+		},
+		{
+			name: "create with semver-pre versioning and extra identifier prerelease",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "semver-pre",
+				"--initial-version", "1.0.0-rc.1.2",
+			},
+			err: command.ErrInvalidVersion,
+		},
+		{
+			name: "create with goreleaser versioning without go style",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "goreleaser",
+			},
+			err: command.ErrVersioningRequiresStyle,
+		},
+		{
+			name: "create with invalid versioning",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--versioning", "notexist",
+			},
+			err: command.ErrInvalidVersioning,
+		},
+		{
+			name: "create with readme sections from profile",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--profile", sectionsProfileFile,
+				"--disable-fork",
+			},
+			lookupInFiles: map[string][]string{
+				"README.md": {
+					"Project description here...\n\n---\n\n## Installation",
+					"## Installation\n\nInstallation information here...\n\n---\n\n## API\n\nSee pkg.go.dev for repo",
+					"## Examples\n\nUsage information here...",
+					"## Contributor(s)\n\n* [",
+				},
+			},
+		},
+		{
+			name: "create with invalid badge",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--badge", "notexist",
+			},
+			err: command.ErrInvalidBadge,
+		},
+		{
+			name: "create with invalid project style",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--project-style", "notexist",
+			},
+			err: command.ErrInvalidProjectStyle,
+		},
+		{
+			name: "create with invalid language",
+			input: []string{
+				"--project-name", "test",
+				"--repository-name", "repo",
+				"--lang", "xx",
+			},
+			err: command.ErrInvalidLanguage,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			args := os.Args[:1]
+			args = append(args, testCase.input...)
+
+			cmd, err := command.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := cmd.Run(args); !errors.Is(err, testCase.err) {
+				t.Errorf("want: %v, got: %v", testCase.err, err)
+			}
+
+			folder := tmpFolder
+			if testCase.folder != "" {
+				folder = strings.Join([]string{tmpDir, testCase.folder}, string(os.PathSeparator))
+			}
+
+			for file, lookups := range testCase.lookupInFiles {
+				filePath := strings.Join([]string{folder, file}, string(os.PathSeparator))
+
+				data, err := os.ReadFile(filePath)
+				if err != nil {
+					t.Errorf("can not open file: %v", err)
+
+					continue
+				}
+
+				for _, lookup := range lookups {
+					if !strings.Contains(string(data), lookup) {
+						t.Errorf("%s does not contain: %s", file, lookup)
+					}
+				}
+			}
+
+			for _, file := range testCase.missingFiles {
+				filePath := strings.Join([]string{folder, file}, string(os.PathSeparator))
+				if _, err := os.Stat(filePath); !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("%s should not exist", filePath)
+				}
+			}
+
+			if err := os.RemoveAll(folder); err != nil {
+				t.Errorf("can not delete temp folder: %v", err)
+			}
+		})
+	}
+}
+
+func TestLicenseReport(t *testing.T) {
+	modCache := t.TempDir()
+	projectDir := t.TempDir()
+	t.Setenv("GOMODCACHE", modCache)
+
+	licenseFiles := map[string]string{
+		"github.com/!some!one/permissive@v1.0.0-!r!c.1": "templates/license/mit.gotxt",
+		"github.com/someone/copyleft@v0.2.0":            "templates/license/gnu-affero-gpl-30.gotxt",
+	}
+
+	for moduleDir, templateFile := range licenseFiles {
+		data, err := os.ReadFile(templateFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dir := strings.Join([]string{modCache, moduleDir}, string(os.PathSeparator))
+		if err = os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(dir+string(os.PathSeparator)+"LICENSE", data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	readmeDir := strings.Join([]string{modCache, "github.com/someone/unlicensed@v1.1.0"}, string(os.PathSeparator))
+	if err := os.MkdirAll(readmeDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(readmeDir+string(os.PathSeparator)+"README.md", []byte("# unlicensed\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	goMod := `// example module
+module github.com/vigo/example
+
+go 1.25
+
+require (
+	github.com/SomeOne/permissive v1.0.0-RC.1
+	github.com/someone/copyleft v0.2.0 // indirect
+	github.com/someone/unlicensed v1.0.0 // replaced
+)
+
+require github.com/someone/missing v1.2.3
+
+replace github.com/someone/unlicensed v1.0.0 => github.com/someone/unlicensed v1.1.0
+
+retract (
+	v0.1.0 // published by mistake
+)
+`
+	goModPath := strings.Join([]string{projectDir, "go.mod"}, string(os.PathSeparator))
+	if err := os.WriteFile(goModPath, []byte(goMod), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		license string
+		want    map[string]string
+		err     error
+	}{
+		{
+			name:    "mit project with agpl dependency",
+			license: "mit",
+			want: map[string]string{
+				"github.com/SomeOne/permissive": "ok",
+				"github.com/someone/copyleft":   "incompatible",
+				"github.com/someone/missing":    "unknown",
+				"github.com/someone/unlicensed": "unknown",
+			},
+			err: command.ErrIncompatibleDependencyLicense,
+		},
+		{
+			name:    "agpl project with agpl dependency",
+			license: "gnu-agpl30",
+			want: map[string]string{
+				"github.com/SomeOne/permissive": "ok",
+				"github.com/someone/copyleft":   "ok",
+				"github.com/someone/missing":    "unknown",
+				"github.com/someone/unlicensed": "unknown",
+			},
+			err: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			args := os.Args[:1]
+			args = append(args, "license-report", "--dir", projectDir, "--license", testCase.license, "--format", "json")
+
+			out := new(bytes.Buffer)
+			cmd, err := command.New(
+				command.WithWriter(out),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = cmd.Run(args); !errors.Is(err, testCase.err) {
+				t.Errorf("want: %v, got: %v", testCase.err, err)
+			}
+
+			var report struct {
+				Dependencies []struct {
+					Module  string `json:"module"`
+					Version string `json:"version"`
+					Status  string `json:"status"`
+					Note    string `json:"note"`
+				} `json:"dependencies"`
+			}
+			if err = json.Unmarshal(out.Bytes(), &report); err != nil {
+				t.Fatalf("can not decode report: %v", err)
+			}
+
+			if len(report.Dependencies) != len(testCase.want) {
+				t.Errorf("want: %d dependencies, got: %d", len(testCase.want), len(report.Dependencies))
+			}
+
+			for _, dep := range report.Dependencies {
+				if testCase.want[dep.Module] != dep.Status {
+					t.Errorf("%s want: %s, got: %s", dep.Module, testCase.want[dep.Module], dep.Status)
+				}
+				unlicensed := dep.Module == "github.com/someone/unlicensed"
+				if unlicensed && (dep.Version != "v1.1.0" || dep.Note != "no license file found") {
+					t.Errorf("want: replaced v1.1.0 with no license file, got: %s %s", dep.Version, dep.Note)
+				}
+			}
+		})
+	}
+}
+
+func TestCodeownersCheck(t *testing.T) {
+	repoDir := t.TempDir()
+
+	for _, fileName := range []string{"README.md", "docs/index.md", "cmd/app/main.go", ".github/CODEOWNERS"} {
+		path := strings.Join([]string{repoDir, fileName}, string(os.PathSeparator))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		codeowners string
+		err        error
+	}{
+		{
+			name: "all patterns match",
+			codeowners: `# comment
+* @vigo
+/.github/ @acme/platform
+/docs/ @acme/docs docs@example.com
+*.go @acme/go
+cmd/** @acme/go
+docs/*.md @acme/docs # inline comment
+`,
+			err: nil,
+		},
+		{
+			name:       "unmatched pattern",
+			codeowners: "* @vigo\n/api/ @acme/api\n",
+			err:        command.ErrCodeownersPatternUnmatched,
+		},
+		{
+			name:       "nested file does not match dir star",
+			codeowners: "/cmd/* @acme/go\n",
+			err:        command.ErrCodeownersPatternUnmatched,
+		},
+		{
+			name:       "invalid owner",
+			codeowners: "* vigo\n",
+			err:        command.ErrInvalidCodeownersOwner,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			codeownersFile := strings.Join([]string{repoDir, ".github", "CODEOWNERS"}, string(os.PathSeparator))
+			if err := os.WriteFile(codeownersFile, []byte(testCase.codeowners), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			args := os.Args[:1]
+			args = append(args, "codeowners", "check", "--dir", repoDir)
+
+			cmd, err := command.New(
+				command.WithWriter(new(bytes.Buffer)),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = cmd.Run(args); !errors.Is(err, testCase.err) {
+				t.Errorf("want: %v, got: %v", testCase.err, err)
+			}
+		})
+	}
+}
+
+func TestCitationSchemas(t *testing.T) {
+	schemaFile, err := os.Open("testdata/cff-1.2.0.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer schemaFile.Close()
+
+	schemaDoc, err := jsonschema.UnmarshalJSON(schemaFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err = compiler.AddResource("cff-1.2.0.schema.json", schemaDoc); err != nil {
+		t.Fatal(err)
+	}
+
+	cffSchema, err := compiler.Compile("cff-1.2.0.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contextData, err := os.ReadFile("testdata/codemeta-3.0.jsonld")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var codemetaContext struct {
+		Context map[string]any `json:"@context"`
+	}
+	if err = json.Unmarshal(contextData, &codemetaContext); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name  string
+		input []string
+	}{
+		{
+			name: "single license with orcid and affiliation",
+			input: []string{
+				"--email", "vigo@example.com",
+				"--username", "vigo",
+				"--license", "apache-20",
+				"--website", "https://example.com",
+				"--orcid", "0000-0002-1825-0097",
+				"--affiliation", "ACME Lab",
+			},
+		},
+		{
+			name: "dual license with composed project styles",
+			input: []string{
+				"--username", "vigo",
+				"--license", "mit-apache-20",
+				"--project-style", "go:backend,node:web",
+				"--versioning", "calver",
+			},
+		},
+		{
+			name:  "without license and username",
+			input: []string{"--disable-license"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Chdir(t.TempDir())
+
+			args := os.Args[:1]
+			args = append(args, "--full-name", "Uğur Özyılmazel", "--project-name", "test", "--repository-name", "repo")
+			args = append(args, "--citation", "--codemeta")
+			args = append(args, testCase.input...)
+
+			cmd, err := command.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = cmd.Run(args); err != nil {
+				t.Fatal(err)
+			}
+
+			citationData, err := os.ReadFile(filepath.Join("repo", "CITATION.cff"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var citation any
+			if err = yaml.Unmarshal(citationData, &citation); err != nil {
+				t.Fatalf("can not decode CITATION.cff: %v", err)
+			}
+
+			if err = cffSchema.Validate(citation); err != nil {
+				t.Errorf("CITATION.cff is not valid: %v", err)
+			}
+
+			codemetaData, err := os.ReadFile(filepath.Join("repo", "codemeta.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var codemeta map[string]any
+			if err = json.Unmarshal(codemetaData, &codemeta); err != nil {
+				t.Fatalf("can not decode codemeta.json: %v", err)
+			}
+
+			if codemeta["@context"] != "https://w3id.org/codemeta/3.0" {
+				t.Errorf("codemeta.json @context want: codemeta 3.0, got: %v", codemeta["@context"])
+			}
+			for _, problem := range codemetaProblems(codemetaContext.Context, codemeta) {
+				t.Errorf("codemeta.json is not valid: %s", problem)
+			}
+		})
+	}
+}
+
+// codemetaProblems checks doc against codemeta JSON-LD context, keys must be
+// defined terms, types must be defined classes and @id terms must be IRIs.
+func codemetaProblems(context map[string]any, doc map[string]any) []string {
+	var problems []string
+
+	for key, value := range doc {
+		switch key {
+		case "@context", "@id":
+			continue
+		case "@type":
+			if _, ok := context[fmt.Sprint(value)]; !ok {
+				problems = append(problems, fmt.Sprintf("undefined type %v", value))
+			}
+
+			continue
+		}
+
+		term, ok := context[key].(map[string]any)
+		if !ok {
+			problems = append(problems, "undefined term "+key)
+
+			continue
+		}
+
+		values, isList := value.([]any)
+		if !isList {
+			values = []any{value}
+		}
+
+		for _, v := range values {
+			switch v := v.(type) {
+			case map[string]any:
+				problems = append(problems, codemetaProblems(context, v)...)
+			case string:
+				if u, err := url.Parse(v); term["@type"] == "@id" && (err != nil || !u.IsAbs()) {
+					problems = append(problems, fmt.Sprintf("%s is not an IRI: %s", key, v))
+				}
+			}
+		}
+	}
+
+	return problems
+}
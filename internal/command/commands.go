@@ -0,0 +1,47 @@
+package command
+
+import "github.com/urfave/cli/v2"
+
+func (k *cmd) getCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "license-report",
+			Usage: "check licenses of go.mod dependencies against your project license",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "license",
+					Aliases: []string{"l"},
+					Usage:   "`LICENSE` of your project",
+					Value:   licenseMIT.String(),
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "report `FORMAT`, table or json",
+					Value: reportFormatTable,
+				},
+				&cli.StringFlag{
+					Name:  "dir",
+					Usage: "`DIR` of go.mod, default is current working directory",
+				},
+			},
+			Action: k.licenseReportAction(),
+		},
+		{
+			Name:  "codeowners",
+			Usage: "CODEOWNERS utilities",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "check",
+					Usage: "verify every CODEOWNERS pattern matches at least one file",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "dir",
+							Usage: "repository `DIR`, default is current working directory",
+						},
+					},
+					Action: k.codeownersCheckAction(),
+				},
+			},
+		},
+	}
+}
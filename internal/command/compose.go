@@ -0,0 +1,479 @@
+package command
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+//go:embed templates/style/dependabot.yml.gotxt
+var templateDependabot string
+
+//go:embed templates/style/pre-commit-config.yaml.gotxt
+var templatePreCommitConfig string
+
+//go:embed templates/style/gitignore.gotxt
+var templateGitIgnore string
+
+type (
+	// styleLayer is a project style generated under Root, empty Root is the
+	// repository root.
+	styleLayer struct {
+		Style projectStyle
+		Root  string
+	}
+
+	styleLayers []styleLayer
+
+	dependabotGroup struct {
+		Name     string
+		Patterns []string
+	}
+
+	dependabotUpdate struct {
+		Ecosystem string
+		Directory string
+		Interval  string
+		Label     string
+		Prefix    string
+		Groups    []dependabotGroup
+	}
+
+	dependabotVariables struct {
+		GitHubUsername string
+		Updates        []dependabotUpdate
+	}
+
+	preCommitHook struct {
+		ID    string
+		Args  []string
+		Files string
+	}
+
+	preCommitRepo struct {
+		Repo  string
+		Rev   string
+		Hooks []preCommitHook
+	}
+
+	gitIgnoreSection struct {
+		Comment  string
+		Patterns []string
+	}
+)
+
+const (
+	fnDependabot      = ".github/dependabot.yml"
+	fnPreCommitConfig = ".pre-commit-config.yaml"
+	fnGitIgnore       = ".gitignore"
+	fnCodecov         = ".codecov.yml"
+)
+
+var reStyleRoot = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*(/[A-Za-z0-9_-][A-Za-z0-9._-]*)*$`)
+
+// sentinel errors.
+var (
+	ErrInvalidStyleRoot      = errors.New("invalid project style root")
+	ErrDuplicateProjectStyle = errors.New("project style used more than once")
+)
+
+// parseProjectStyles parses STYLE[:ROOT] values, ex: go:backend,node:web.
+func parseProjectStyles(values []string) (styleLayers, error) {
+	layers := make(styleLayers, 0, len(values))
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		name, root, _ := strings.Cut(value, ":")
+		style := projectStyle(name)
+		if _, ok := availableProjectStyles()[style]; !ok {
+			return nil, fmt.Errorf("%w `%s`", ErrInvalidProjectStyle, name)
+		}
+		if layers.has(style) {
+			return nil, fmt.Errorf("%w `%s`", ErrDuplicateProjectStyle, name)
+		}
+
+		root = strings.Trim(root, "/")
+		if root == "." {
+			root = ""
+		}
+		if root != "" && (!reStyleRoot.MatchString(root) || path.Clean(root) != root) {
+			return nil, fmt.Errorf("%w `%s`, use a relative folder, ex: go:backend", ErrInvalidStyleRoot, value)
+		}
+
+		layers = append(layers, styleLayer{Style: style, Root: root})
+	}
+
+	// docker without root builds the first language style, ex: go:backend,docker
+	// puts Dockerfile under backend/.
+	if idx := slices.IndexFunc(layers, func(l styleLayer) bool { return l.Style == projectStyleDocker }); idx != -1 {
+		if languages := layers.containerLayers(); layers[idx].Root == "" && len(languages) > 0 {
+			layers[idx].Root = languages[0].Root
+		}
+	}
+
+	return layers, nil
+}
+
+func (sl styleLayers) String() string {
+	values := make([]string, 0, len(sl))
+	for _, layer := range sl {
+		value := layer.Style.String()
+		if layer.Root != "" {
+			value += ":" + layer.Root
+		}
+		values = append(values, value)
+	}
+
+	return strings.Join(values, ",")
+}
+
+func (sl styleLayers) has(ps projectStyle) bool {
+	return slices.ContainsFunc(sl, func(layer styleLayer) bool { return layer.Style == ps })
+}
+
+// root returns root folder of the style, empty for repository root or when
+// style is not used.
+func (sl styleLayers) root(ps projectStyle) string {
+	for _, layer := range sl {
+		if layer.Style == ps {
+			return layer.Root
+		}
+	}
+
+	return ""
+}
+
+// languageLayers returns layers of programming language styles, tooling
+// styles like docker are left out.
+func (sl styleLayers) languageLayers() styleLayers {
+	return slices.DeleteFunc(slices.Clone(sl), func(layer styleLayer) bool {
+		return projectStyleDefinitions()[layer.Style].ProgrammingLanguage == ""
+	})
+}
+
+// definition merges definitions of the layers, files are left out since they
+// are generated per layer by generateStyleFiles.
+func (sl styleLayers) definition() projectStyleDefinition {
+	var merged projectStyleDefinition
+	for _, layer := range sl {
+		def := projectStyleDefinitions()[layer.Style]
+
+		merged.Workflows = append(merged.Workflows, def.Workflows...)
+		for _, bt := range def.Badges {
+			if !slices.Contains(merged.Badges, bt) {
+				merged.Badges = append(merged.Badges, bt)
+			}
+		}
+		for _, file := range def.VersionFiles {
+			file.Filename = path.Join(layer.Root, file.Filename)
+			merged.VersionFiles = append(merged.VersionFiles, file)
+		}
+		for _, dc := range def.DevelopmentCommands {
+			if layer.Root != "" {
+				dc.Description += " in `" + layer.Root + "/`"
+			}
+			merged.DevelopmentCommands = append(merged.DevelopmentCommands, dc)
+		}
+		for _, ec := range def.EnvironmentCommands {
+			if !slices.Contains(merged.EnvironmentCommands, ec) {
+				merged.EnvironmentCommands = append(merged.EnvironmentCommands, ec)
+			}
+		}
+		for _, field := range def.IssueFields {
+			if !slices.ContainsFunc(merged.IssueFields, func(f issueFormField) bool { return f.ID == field.ID }) {
+				merged.IssueFields = append(merged.IssueFields, field)
+			}
+		}
+		merged.PullRequestChecks = append(merged.PullRequestChecks, def.PullRequestChecks...)
+		for _, repo := range def.PreCommit {
+			if layer.Root != "" {
+				hooks := make([]preCommitHook, 0, len(repo.Hooks))
+				for _, hook := range repo.Hooks {
+					hook.Files = "^" + regexp.QuoteMeta(layer.Root+"/")
+					hooks = append(hooks, hook)
+				}
+				repo.Hooks = hooks
+			}
+			merged.PreCommit = append(merged.PreCommit, repo)
+		}
+		if merged.DefaultLicense == "" {
+			merged.DefaultLicense = def.DefaultLicense
+		}
+	}
+	if len(merged.PreCommit) > 0 {
+		merged.DevelopmentCommands = append(merged.DevelopmentCommands, developmentCommand{
+			Description: "install pre-commit hooks",
+			Command:     "pre-commit install",
+		})
+	}
+	merged.ReadmeSections = sl.readmeSections()
+	merged.Dependabot = dependabotUpdates(sl)
+
+	return merged
+}
+
+// readmeSections combines same named sections of the layers, each body gets
+// a sub title with style title and root folder.
+func (sl styleLayers) readmeSections() []readmeSection {
+	if len(sl) == 1 {
+		return projectStyleDefinitions()[sl[0].Style].ReadmeSections
+	}
+
+	var sections []readmeSection
+
+	for _, layer := range sl {
+		def := projectStyleDefinitions()[layer.Style]
+		for _, section := range def.ReadmeSections {
+			title := def.Title
+			if layer.Root != "" {
+				title += " (`" + layer.Root + "/`)"
+			}
+			body := "### " + title + "\n\n" + strings.TrimSpace(section.Body)
+
+			idx := slices.IndexFunc(sections, func(s readmeSection) bool { return s.Name == section.Name })
+			if idx == -1 {
+				section.Body = body
+				sections = append(sections, section)
+
+				continue
+			}
+			sections[idx].Body += "\n\n" + body
+		}
+	}
+
+	return sections
+}
+
+// programmingLanguages returns languages of the layers for codemeta.json.
+func (sl styleLayers) programmingLanguages(vars *readmeVariables) []string {
+	var languages []string
+	for _, layer := range sl {
+		lang := projectStyleDefinitions()[layer.Style].ProgrammingLanguage
+		if layer.Style == projectStyleNode {
+			lang = vars.Node.programmingLanguage()
+		}
+		if lang != "" && !slices.Contains(languages, lang) {
+			languages = append(languages, lang)
+		}
+	}
+
+	return languages
+}
+
+// releaseType returns release-please type of a single language style,
+// polyglot repositories are released as simple.
+func (sl styleLayers) releaseType() string {
+	if languages := sl.languageLayers(); len(languages) == 1 {
+		return releaseType(languages[0].Style)
+	}
+
+	return releaseType("")
+}
+
+// dependabotUpdates returns update entries of the layers with their root as
+// directory, github-actions entry of repository root is shared.
+func dependabotUpdates(sl styleLayers) []dependabotUpdate {
+	var updates []dependabotUpdate
+	for _, layer := range sl {
+		for _, update := range projectStyleDefinitions()[layer.Style].Dependabot {
+			update.Directory = "/" + layer.Root
+			updates = append(updates, update)
+		}
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	shared := dependabotUpdate{
+		Ecosystem: "github-actions",
+		Directory: "/",
+		Interval:  "weekly",
+		Label:     "github-actions",
+		Prefix:    "[gha] - upgrade github action dependencies",
+	}
+
+	return append([]dependabotUpdate{shared}, slices.DeleteFunc(updates, func(u dependabotUpdate) bool {
+		return u.Ecosystem == shared.Ecosystem && u.Directory == shared.Directory
+	})...)
+}
+
+// repositoryFile reports files that belong to repository root whatever the
+// style root is.
+func repositoryFile(name string) bool {
+	return strings.HasPrefix(name, ".github/") || name == fnCodecov || name == fnGoReleaser
+}
+
+// generateStyleFiles generates files of each layer under its root, shared
+// files are generated once and .gitignore, dependabot.yml and
+// .pre-commit-config.yaml are merged.
+func (k *cmd) generateStyleFiles(targetFolder string, layers styleLayers, vars *readmeVariables) error {
+	var (
+		files     []styleFile
+		gitIgnore []gitIgnoreSection
+	)
+
+	for _, layer := range layers {
+		for _, file := range projectStyleDefinitions()[layer.Style].files(vars) {
+			if file.Path == fnGitIgnore {
+				sections, err := renderGitIgnore(file.Template, vars, layer.Root)
+				if err != nil {
+					return err
+				}
+				gitIgnore = mergeGitIgnoreSections(gitIgnore, sections)
+
+				continue
+			}
+
+			if !repositoryFile(file.Path) {
+				file.Path = path.Join(layer.Root, file.Path)
+			}
+			if !slices.ContainsFunc(files, func(f styleFile) bool { return f.Path == file.Path }) {
+				files = append(files, file)
+			}
+		}
+	}
+
+	if err := k.generateTemplateFiles(targetFolder, templateStyles, files, vars); err != nil {
+		return err
+	}
+
+	def := layers.definition()
+	if len(def.Dependabot) > 0 {
+		dependabotVars := dependabotVariables{GitHubUsername: vars.GitHubUsername, Updates: def.Dependabot}
+		if err := k.generateFile(targetFolder, fnDependabot, &dependabotVars, templateDependabot); err != nil {
+			return err
+		}
+	}
+	if len(def.PreCommit) > 0 {
+		if err := k.generateFile(targetFolder, fnPreCommitConfig, def.PreCommit, templatePreCommitConfig); err != nil {
+			return err
+		}
+	}
+	if len(gitIgnore) > 0 {
+		if err := k.generateFile(targetFolder, fnGitIgnore, gitIgnore, templateGitIgnore); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k *cmd) generateFile(targetFolder, fileName string, vars any, tmpl string) error {
+	filePath := strings.Join(
+		[]string{targetFolder, fileName},
+		string(os.PathSeparator),
+	)
+
+	if err := k.GenerateTextFromTemplate(filePath, vars, tmpl); err != nil {
+		return fmt.Errorf("could not generate %s file, %w", fileName, err)
+	}
+
+	return nil
+}
+
+// renderGitIgnore renders .gitignore template of a style and parses it as
+// sections, anchored patterns are moved under root.
+func renderGitIgnore(templateName string, vars *readmeVariables, root string) ([]gitIgnoreSection, error) {
+	data, err := templateStyles.ReadFile(templateName)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s template, %w", fnGitIgnore, err)
+	}
+
+	content, err := executeTemplateString(fnGitIgnore, string(data), vars)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate %s file, %w", fnGitIgnore, err)
+	}
+
+	return parseGitIgnore(content, root), nil
+}
+
+// parseGitIgnore splits .gitignore content as sections, a section starts with
+// a comment and ends with a blank line.
+func parseGitIgnore(content, root string) []gitIgnoreSection {
+	var (
+		sections []gitIgnoreSection
+		current  gitIgnoreSection
+	)
+
+	flush := func() {
+		if current.Comment != "" || len(current.Patterns) > 0 {
+			sections = append(sections, current)
+		}
+		current = gitIgnoreSection{}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#"):
+			if len(current.Patterns) > 0 {
+				flush()
+			}
+			current.Comment = line
+		default:
+			current.Patterns = append(current.Patterns, gitIgnorePattern(line, root))
+		}
+	}
+	flush()
+
+	return sections
+}
+
+// gitIgnorePattern moves pattern under root when it is relative to the
+// .gitignore location, a separator at the beginning or middle anchors it.
+func gitIgnorePattern(pattern, root string) string {
+	if root == "" {
+		return pattern
+	}
+
+	negate := strings.HasPrefix(pattern, "!")
+	p := strings.TrimPrefix(pattern, "!")
+	if !strings.Contains(strings.TrimSuffix(p, "/"), "/") || strings.HasPrefix(p, "**/") {
+		return pattern
+	}
+
+	p = "/" + root + "/" + strings.TrimPrefix(p, "/")
+	if negate {
+		p = "!" + p
+	}
+
+	return p
+}
+
+// mergeGitIgnoreSections appends patterns to sections with the same comment,
+// patterns already ignored are skipped.
+func mergeGitIgnoreSections(sections, others []gitIgnoreSection) []gitIgnoreSection {
+	for _, other := range others {
+		patterns := slices.DeleteFunc(slices.Clone(other.Patterns), func(p string) bool {
+			return slices.ContainsFunc(sections, func(s gitIgnoreSection) bool { return slices.Contains(s.Patterns, p) })
+		})
+		if len(patterns) == 0 {
+			continue
+		}
+
+		idx := slices.IndexFunc(sections, func(s gitIgnoreSection) bool {
+			return other.Comment != "" && s.Comment == other.Comment
+		})
+		if idx == -1 {
+			sections = append(sections, gitIgnoreSection{Comment: other.Comment, Patterns: patterns})
+
+			continue
+		}
+		sections[idx].Patterns = append(sections[idx].Patterns, patterns...)
+	}
+
+	return sections
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	_ "embed"
+	"errors"
+)
+
+//go:embed templates/contributing.gotxt
+var templateContributing string
+
+//go:embed templates/support.gotxt
+var templateSupport string
+
+type (
+	contributorAgreement  string
+	contributorAgreements map[contributorAgreement]string
+
+	developmentCommand struct {
+		Description string
+		Command     string
+	}
+
+	// contributingVariables holds CONTRIBUTING and SUPPORT values, COCFileName
+	// shadows the localized one of readmeVariables, both files are written in
+	// primary language and link the primary code of conduct.
+	contributingVariables struct {
+		*readmeVariables
+		COCFileName         string
+		Agreement           string
+		CLAURL              string
+		ConventionalCommits bool
+		DevelopmentCommands []developmentCommand
+		EnvironmentCommands []string
+	}
+)
+
+func (ca contributorAgreement) String() string {
+	return string(ca)
+}
+
+const (
+	fnContributing = "CONTRIBUTING.md"
+	fnSupport      = "SUPPORT.md"
+
+	contributorAgreementDCO  = contributorAgreement("dco")
+	contributorAgreementCLA  = contributorAgreement("cla")
+	contributorAgreementNone = contributorAgreement("none")
+)
+
+// sentinel errors.
+var (
+	ErrInvalidContributorAgreement = errors.New("invalid contributor agreement option")
+	ErrCLAURLRequired              = errors.New("cla url required")
+)
+
+func availableContributorAgreements() contributorAgreements {
+	return contributorAgreements{
+		contributorAgreementDCO:  "Developer Certificate of Origin, commits are signed off",
+		contributorAgreementCLA:  "Contributor License Agreement, requires --cla-url",
+		contributorAgreementNone: "no agreement statement",
+	}
+}
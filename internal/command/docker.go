@@ -0,0 +1,96 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// dockerVariables holds docker style values used by Dockerfile, compose.yaml
+// and image publishing workflow. Style is the language style built into the
+// image, empty for a stand-alone image.
+type dockerVariables struct {
+	Style   string
+	Image   string
+	Service string
+	Port    string
+	Root    string
+}
+
+const (
+	projectStyleDocker = projectStyle("docker")
+
+	dockerRegistry    = "ghcr.io"
+	dockerServicePort = "8080"
+)
+
+var reDockerService = regexp.MustCompile(`[^a-z0-9_.-]+`)
+
+// sentinel errors.
+var ErrDockerRequiresExecutable = errors.New("docker style requires an executable")
+
+// newDockerVariables resolves language layer of the docker layer, library
+// layouts have nothing to run in a container.
+func newDockerVariables(
+	layers styleLayers, username, repository string, goVars goVariables, rustVars rustVariables,
+) (dockerVariables, error) {
+	target := layers.dockerTarget()
+
+	switch {
+	case target.Style == projectStyleGo && goLayout(goVars.Layout) == goLayoutLibrary:
+		return dockerVariables{}, fmt.Errorf("%w, use go cli or service layout", ErrDockerRequiresExecutable)
+	case target.Style == projectStyleRust && rustCrateType(rustVars.CrateType) == rustCrateTypeLib:
+		return dockerVariables{}, fmt.Errorf("%w, use rust bin crate type", ErrDockerRequiresExecutable)
+	}
+
+	vars := dockerVariables{
+		Style:   target.Style.String(),
+		Image:   strings.ToLower(dockerRegistry + "/" + username + "/" + repository),
+		Service: strings.Trim(reDockerService.ReplaceAllString(strings.ToLower(repository), "-"), "._-"),
+		Root:    layers.root(projectStyleDocker),
+	}
+	if vars.Service == "" {
+		vars.Service = "app"
+	}
+	if target.Style == projectStyleGo && goLayout(goVars.Layout) == goLayoutService {
+		vars.Port = dockerServicePort
+	}
+
+	return vars, nil
+}
+
+// containerLayers returns language layers having a Dockerfile, ex: terraform
+// modules are not containerized.
+func (sl styleLayers) containerLayers() styleLayers {
+	return slices.DeleteFunc(sl.languageLayers(), func(layer styleLayer) bool {
+		return !slices.Contains(
+			[]projectStyle{projectStyleGo, projectStylePython, projectStyleRust, projectStyleNode},
+			layer.Style,
+		)
+	})
+}
+
+// dockerTarget returns language layer sharing the root of docker layer, zero
+// value for a stand-alone image.
+func (sl styleLayers) dockerTarget() styleLayer {
+	root := sl.root(projectStyleDocker)
+	for _, layer := range sl.containerLayers() {
+		if layer.Root == root {
+			return layer
+		}
+	}
+
+	return styleLayer{}
+}
+
+// dockerDynamicFiles returns Dockerfile of the language built into the image.
+func dockerDynamicFiles(vars *readmeVariables) []styleFile {
+	name := vars.Docker.Style
+	if name == "" {
+		name = "default"
+	}
+
+	return []styleFile{{Path: "Dockerfile", Template: "templates/style/docker/dockerfile/" + name + ".gotxt"}}
+}
@@ -29,6 +29,10 @@ AVALILABLE PROJECT STYLE(S) (%d):
 
 %s
 
+AVALILABLE CODE OF CONDUCT(S) (%d):
+
+%s
+
 EXAMPLES:
 
   $ git init-githubrepo -p "My Awesome Project" -r "hello-world"
@@ -38,6 +42,10 @@ EXAMPLES:
   $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --disable-fork --disable-bumpversion --disable-coc --disable-license
   $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --license gnu-agpl30
   $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --license moz-p20
+  $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --coc citizen --coc-contact conduct@example.com
+  $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --coc-file ~/my-coc.md
+  $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --lang en,tr
+  $ git init-githubrepo -p "My Awesome Project" -r "hello-world" --ps go:backend,node:web
 
 `
 }
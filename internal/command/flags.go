@@ -36,10 +36,64 @@ func (c *cmd) getFlags() []cli.Flag {
 			Usage:   "`NAME` of your project",
 		},
 
-		&cli.StringFlag{
+		&cli.StringSliceFlag{
 			Name:    "project-style",
 			Aliases: []string{"ps"},
-			Usage:   "style of your project",
+			Usage:   "`STYLE`(s) of your project with optional root folder, ex: go or go:backend,node:web",
+		},
+
+		&cli.StringFlag{
+			Name:  "profile",
+			Usage: "load defaults from profile `FILE` (JSON)",
+		},
+
+		&cli.StringFlag{
+			Name:  "go-layout",
+			Usage: "`LAYOUT` of go project style: cli, library or service",
+			Value: goLayoutCLI.String(),
+		},
+
+		&cli.StringFlag{
+			Name:  "go-module",
+			Usage: "go module `PATH` (default: HOST/USERNAME/REPOSITORY)",
+		},
+
+		&cli.StringFlag{
+			Name:  "go-module-host",
+			Usage: "`HOST` of go module path, ex: github.example.com or go.example.com",
+			Value: defaultGoModuleHost,
+		},
+
+		&cli.BoolFlag{
+			Name:  "go-release",
+			Usage: "add goreleaser config and tag triggered release workflow to go project style",
+		},
+
+		&cli.StringFlag{
+			Name:  "homebrew-tap",
+			Usage: "`OWNER/NAME` of homebrew tap repository for go release bundle, ex: vigo/homebrew-tap",
+		},
+
+		&cli.StringFlag{
+			Name:  "go-version",
+			Usage: "go `VERSION` of go.mod (default: go env GOVERSION)",
+		},
+
+		&cli.StringFlag{
+			Name:  "rust-crate-type",
+			Usage: "`TYPE` of rust project style crate: bin or lib",
+			Value: rustCrateTypeBin.String(),
+		},
+
+		&cli.BoolFlag{
+			Name:  "ts",
+			Usage: "use TypeScript in node project style",
+		},
+
+		&cli.StringFlag{
+			Name:  "action-type",
+			Usage: "`TYPE` of action project style: composite, docker or node",
+			Value: actionTypeComposite.String(),
 		},
 
 		&cli.StringFlag{
@@ -67,11 +121,85 @@ func (c *cmd) getFlags() []cli.Flag {
 			Usage:   "list project styles",
 		},
 
+		&cli.StringSliceFlag{
+			Name:  "lang",
+			Usage: "`LANGUAGE`(s) of README and CODE_OF_CONDUCT, first one is primary, ex: en,tr",
+			Value: cli.NewStringSlice(languageEnglish.String()),
+		},
+
+		&cli.StringFlag{
+			Name:  "coc",
+			Usage: "`TYPE` of CODE_OF_CONDUCT",
+			Value: cocContributorCovenant21.String(),
+		},
+
+		&cli.StringFlag{
+			Name:  "coc-file",
+			Usage: "use custom CODE_OF_CONDUCT text from `FILE`",
+		},
+
+		&cli.StringSliceFlag{
+			Name:  "coc-contact",
+			Usage: "code of conduct enforcement `CONTACT`, can be used multiple times (default: EMAIL)",
+		},
+
+		&cli.StringFlag{
+			Name:  "coc-report-url",
+			Usage: "`URL` for reporting code of conduct violations",
+		},
+
+		&cli.StringSliceFlag{
+			Name:  "badge",
+			Usage: "README `BADGE`(s) to use instead of automatically chosen ones, ex: version,license",
+		},
+
+		&cli.StringSliceFlag{
+			Name:  "disable-badge",
+			Usage: "remove `BADGE`(s) from automatically chosen ones",
+		},
+
+		&cli.StringFlag{
+			Name:  "versioning",
+			Usage: "versioning `SCHEME` of your project",
+			Value: versioningSemver.String(),
+		},
+
+		&cli.StringFlag{
+			Name:  "initial-version",
+			Usage: "initial `VERSION` of your project for badge and version files (default: \"0.0.0\", YYYY.MM.0 for calver)",
+		},
+
 		&cli.BoolFlag{
 			Name:  "disable-bumpversion",
 			Usage: "do not create .bumpversion.cfg and badge to README",
 		},
 
+		&cli.BoolFlag{
+			Name:  "disable-changelog",
+			Usage: "do not add CHANGELOG.md",
+		},
+
+		&cli.BoolFlag{
+			Name:  "disable-contributing",
+			Usage: "do not add CONTRIBUTING.md, README gets fork instructions instead",
+		},
+
+		&cli.BoolFlag{
+			Name:  "disable-support",
+			Usage: "do not add SUPPORT.md",
+		},
+
+		&cli.StringFlag{
+			Name:  "contributor-agreement",
+			Usage: "contributor `AGREEMENT` statement of CONTRIBUTING.md: dco, cla or none",
+			Value: contributorAgreementDCO.String(),
+		},
+
+		&cli.StringFlag{
+			Name:  "cla-url",
+			Usage: "`URL` of your contributor license agreement",
+		},
+
 		&cli.BoolFlag{
 			Name:  "disable-coc",
 			Usage: "do not add CODE_OF_CONDUCT",
@@ -87,6 +215,11 @@ func (c *cmd) getFlags() []cli.Flag {
 			Usage: "do not add fork information to README",
 		},
 
+		&cli.StringSliceFlag{
+			Name:  "funding",
+			Usage: "`PLATFORM=VALUE` for FUNDING.yml, can be used multiple times, ex: github=vigo",
+		},
+
 		&cli.BoolFlag{
 			Name:  "disable-funding",
 			Usage: "do not add FUNDING.yml file",
@@ -102,11 +235,61 @@ func (c *cmd) getFlags() []cli.Flag {
 			Usage: "do not add LICENSE file",
 		},
 
+		&cli.StringFlag{
+			Name:  "website",
+			Usage: "`URL` of your project website, enables .well-known/security.txt",
+		},
+
+		&cli.BoolFlag{
+			Name:  "citation",
+			Usage: "add CITATION.cff file",
+		},
+
+		&cli.BoolFlag{
+			Name:  "codemeta",
+			Usage: "add codemeta.json file",
+		},
+
+		&cli.StringFlag{
+			Name:  "orcid",
+			Usage: "your `ORCID` iD for citation files, ex: 0000-0002-1825-0097",
+		},
+
+		&cli.StringFlag{
+			Name:  "affiliation",
+			Usage: "your `AFFILIATION` for citation files",
+		},
+
+		&cli.StringSliceFlag{
+			Name:  "security-contact",
+			Usage: "security reporting `CONTACT` (email or URL), can be used multiple times (default: EMAIL)",
+		},
+
+		&cli.BoolFlag{
+			Name:  "disable-private-reporting",
+			Usage: "do not offer GitHub private vulnerability reporting in SECURITY.md",
+		},
+
+		&cli.StringFlag{
+			Name:  "security-pgp-key",
+			Usage: "ASCII armored PGP public key `FILE` for encrypted reports",
+		},
+
 		&cli.BoolFlag{
 			Name:  "disable-security",
 			Usage: "do not create SECURITY.md file",
 		},
 
+		&cli.StringSliceFlag{
+			Name:  "pull-request-template",
+			Usage: "additional pull request `TEMPLATE`(s) under .github/PULL_REQUEST_TEMPLATE/: feature, bugfix, release",
+		},
+
+		&cli.BoolFlag{
+			Name:  "require-issue-link",
+			Usage: "require pull requests to link an issue, adds checklist item and workflow",
+		},
+
 		&cli.BoolFlag{
 			Name:  "disable-pull-request-template",
 			Usage: "do not create pull_request_template.md file",
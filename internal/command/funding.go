@@ -0,0 +1,167 @@
+package command
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+//go:embed templates/funding.gotxt
+var templateFunding string
+
+type (
+	// fundingValues accepts a single value or a list in profile, both
+	// "github": "vigo" and "github": ["vigo", "octocat"] are valid.
+	fundingValues []string
+
+	fundingPlatform struct {
+		Key         string
+		Description string
+		Format      *regexp.Regexp
+		MaxValues   int
+		URL         bool
+	}
+
+	// fundingEntry is a FUNDING.yml line, values are ready to be written as
+	// YAML scalars.
+	fundingEntry struct {
+		Key    string
+		Values []string
+	}
+
+	fundingVariables struct {
+		Entries []fundingEntry
+	}
+)
+
+const (
+	fnFunding = ".github/FUNDING.yml"
+
+	maxFundingValues = 4
+)
+
+var (
+	reFundingGitHub = regexp.MustCompile(`^[A-Za-z0-9](?:-?[A-Za-z0-9]){0,38}$`)
+	reFundingSlug   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+)
+
+// sentinel errors.
+var (
+	ErrInvalidFundingPlatform = errors.New("invalid funding platform")
+	ErrInvalidFundingValue    = errors.New("invalid funding value")
+)
+
+func (fv *fundingValues) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*fv = fundingValues{single}
+
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("funding value must be a string or list of strings, %w", err)
+	}
+	*fv = list
+
+	return nil
+}
+
+// fundingPlatforms returns GitHub funding keys in FUNDING.yml order.
+func fundingPlatforms() []fundingPlatform {
+	return []fundingPlatform{
+		{Key: "github", Description: "GitHub Sponsors username", Format: reFundingGitHub, MaxValues: maxFundingValues},
+		{Key: "patreon", Description: "Patreon username", Format: reFundingSlug},
+		{Key: "open_collective", Description: "Open Collective slug", Format: reFundingSlug},
+		{Key: "ko_fi", Description: "Ko-fi username", Format: reFundingSlug},
+		{
+			Key:         "tidelift",
+			Description: "Tidelift platform-name/package-name, ex: npm/babel",
+			Format:      regexp.MustCompile(`^[a-z]+/\S+$`),
+		},
+		{Key: "community_bridge", Description: "LFX Mentorship project name", Format: reFundingSlug},
+		{Key: "liberapay", Description: "Liberapay username", Format: reFundingSlug},
+		{Key: "issuehunt", Description: "IssueHunt username", Format: reFundingSlug},
+		{Key: "lfx_crowdfunding", Description: "LFX Crowdfunding project name", Format: reFundingSlug},
+		{Key: "polar", Description: "Polar username", Format: reFundingSlug},
+		{Key: "buy_me_a_coffee", Description: "Buy Me a Coffee username", Format: reFundingSlug},
+		{
+			Key:         "thanks_dev",
+			Description: "thanks.dev account, ex: u/gh/vigo",
+			Format:      regexp.MustCompile(`^u/(gh|gl)/[A-Za-z0-9_.-]+$`),
+		},
+		{Key: "custom", Description: "custom funding URL", MaxValues: maxFundingValues, URL: true},
+	}
+}
+
+// parseFundingFlags converts platform=value flags to funding values, same
+// platform can be given multiple times.
+func parseFundingFlags(flags []string) (map[string]fundingValues, error) {
+	funding := make(map[string]fundingValues)
+
+	for _, flag := range flags {
+		key, value, ok := strings.Cut(flag, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w `%s`, use PLATFORM=VALUE", ErrInvalidFundingValue, flag)
+		}
+		key = strings.TrimSpace(key)
+		funding[key] = append(funding[key], strings.TrimSpace(value))
+	}
+
+	return funding, nil
+}
+
+// fundingEntries validates funding values and returns them in FUNDING.yml
+// order, platforms without values are left out.
+func fundingEntries(funding map[string]fundingValues) ([]fundingEntry, error) {
+	platforms := fundingPlatforms()
+
+	for key := range funding {
+		if !slices.ContainsFunc(platforms, func(p fundingPlatform) bool { return p.Key == key }) {
+			return nil, fmt.Errorf("%w `%s`", ErrInvalidFundingPlatform, key)
+		}
+	}
+
+	var entries []fundingEntry
+
+	for _, platform := range platforms {
+		values := funding[platform.Key]
+		if len(values) == 0 {
+			continue
+		}
+
+		maxValues := max(platform.MaxValues, 1)
+		if len(values) > maxValues {
+			return nil, fmt.Errorf("%w, `%s` accepts at most %d value(s)", ErrInvalidFundingValue, platform.Key, maxValues)
+		}
+
+		entry := fundingEntry{Key: platform.Key}
+		for _, value := range values {
+			valid := validHTTPURL(value)
+			if !platform.URL {
+				valid = platform.Format.MatchString(value)
+			}
+			if !valid {
+				return nil, fmt.Errorf(
+					"%w `%s` for `%s`, expected %s",
+					ErrInvalidFundingValue, value, platform.Key, platform.Description,
+				)
+			}
+
+			if platform.URL {
+				value = strconv.Quote(value)
+			}
+			entry.Values = append(entry.Values, value)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
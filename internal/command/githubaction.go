@@ -0,0 +1,101 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type (
+	actionType  string
+	actionTypes map[actionType]string
+
+	// actionVariables holds action style values used by action.yml, action
+	// sources and workflows.
+	actionVariables struct {
+		Type        string
+		Package     string
+		NodeVersion string
+		Root        string
+	}
+)
+
+func (at actionType) String() string {
+	return string(at)
+}
+
+const (
+	projectStyleAction = projectStyle("action")
+
+	actionTypeComposite = actionType("composite")
+	actionTypeDocker    = actionType("docker")
+	actionTypeNode      = actionType("node")
+
+	actionNodeVersion = "24"
+)
+
+// sentinel errors.
+var (
+	ErrInvalidActionType = errors.New("invalid action type option")
+	ErrActionNodeStyle   = errors.New("node action can not share root with node style")
+)
+
+func availableActionTypes() actionTypes {
+	return actionTypes{
+		actionTypeComposite: "composite action with a bash step",
+		actionTypeDocker:    "docker container action with Dockerfile and entrypoint.sh",
+		actionTypeNode:      "javascript action bundled to dist/ with @vercel/ncc",
+	}
+}
+
+func newActionVariables(typ, repository string, layers styleLayers) (actionVariables, error) {
+	if _, ok := availableActionTypes()[actionType(typ)]; !ok {
+		keys := make([]string, 0, len(availableActionTypes()))
+		for k := range availableActionTypes() {
+			keys = append(keys, "`"+k.String()+"`")
+		}
+		sort.Strings(keys)
+
+		return actionVariables{}, fmt.Errorf(
+			"%w `%s`. valid action types are: %s",
+			ErrInvalidActionType,
+			typ,
+			strings.Join(keys, ", "),
+		)
+	}
+
+	root := layers.root(projectStyleAction)
+	if actionType(typ) == actionTypeNode && layers.has(projectStyleNode) && layers.root(projectStyleNode) == root {
+		return actionVariables{}, fmt.Errorf("%w, ex: node,action:action", ErrActionNodeStyle)
+	}
+
+	return actionVariables{
+		Type:        typ,
+		Package:     nodePackageName(repository),
+		NodeVersion: actionNodeVersion,
+		Root:        root,
+	}, nil
+}
+
+// actionDynamicFiles returns sources of the action type, node action keeps
+// its bundle under dist/ so only node_modules/ is ignored.
+func actionDynamicFiles(vars *readmeVariables) []styleFile {
+	switch actionType(vars.Action.Type) {
+	case actionTypeDocker:
+		return []styleFile{
+			{Path: "Dockerfile", Template: "templates/style/action/docker/Dockerfile.gotxt"},
+			{Path: "entrypoint.sh", Template: "templates/style/action/docker/entrypoint.sh.gotxt"},
+		}
+	case actionTypeNode:
+		return []styleFile{
+			{Path: "package.json", Template: "templates/style/action/node/package.json.gotxt"},
+			{Path: "src/index.js", Template: "templates/style/action/node/index.js.gotxt"},
+			{Path: "src/greet.js", Template: "templates/style/action/node/greet.js.gotxt"},
+			{Path: "test/greet.test.js", Template: "templates/style/action/node/greet.test.js.gotxt"},
+			{Path: ".gitignore", Template: "templates/style/action/node/gitignore.gotxt"},
+		}
+	default:
+		return nil
+	}
+}
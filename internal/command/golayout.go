@@ -0,0 +1,266 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+type (
+	goLayout  string
+	goLayouts map[goLayout]string
+
+	// goVariables holds go style values used by go.mod, layout files and
+	// README installation section.
+	goVariables struct {
+		Module  string
+		Version string
+		Layout  string
+		Package string
+		Root    string
+
+		Release               bool
+		HomebrewTap           string
+		HomebrewTapOwner      string
+		HomebrewTapRepository string
+	}
+)
+
+func (gl goLayout) String() string {
+	return string(gl)
+}
+
+const (
+	goLayoutCLI     = goLayout("cli")
+	goLayoutLibrary = goLayout("library")
+	goLayoutService = goLayout("service")
+
+	defaultGoModuleHost = "github.com"
+
+	fnGoReleaser = ".goreleaser.yaml"
+	fnGoVersion  = "internal/version/version.go"
+)
+
+var (
+	reGoVersion = regexp.MustCompile(`^1\.\d+(\.\d+|rc\d+)?$`)
+	reGoModule  = regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)+(/[A-Za-z0-9._~-]+)*$`)
+	reGoPackage = regexp.MustCompile(`[^a-z0-9]`)
+	reGoTap     = regexp.MustCompile(`^([A-Za-z0-9](?:-?[A-Za-z0-9]){0,38})/(?:homebrew-)?([A-Za-z0-9._-]+)$`)
+)
+
+// sentinel errors.
+var (
+	ErrInvalidGoLayout  = errors.New("invalid go layout option")
+	ErrInvalidGoVersion = errors.New("invalid go version")
+	ErrInvalidGoModule  = errors.New("invalid go module path")
+
+	ErrInvalidHomebrewTap  = errors.New("invalid homebrew tap")
+	ErrGoReleaseLayout     = errors.New("go release bundle requires cli or service layout")
+	ErrGoReleaseVersioning = errors.New("go release bundle requires semver, semver-pre, calver or goreleaser versioning")
+)
+
+func availableGoLayouts() goLayouts {
+	return goLayouts{
+		goLayoutCLI:     "cmd/<repo>/main.go with -version flag and its test",
+		goLayoutLibrary: "root package with a testable example",
+		goLayoutService: "cmd/<repo>/main.go http server with /healthz and its test",
+	}
+}
+
+// goLayoutFiles returns files of the layout, go.mod is common to all layouts
+// and defined in go style. Only executables get internal/version.
+func goLayoutFiles(layout goLayout, repository, pkg string) []styleFile {
+	switch layout {
+	case goLayoutLibrary:
+		return []styleFile{
+			{Path: pkg + ".go", Template: "templates/style/go/layout/library/package.go.gotxt"},
+			{Path: "example_test.go", Template: "templates/style/go/layout/library/example_test.go.gotxt"},
+		}
+	case goLayoutService:
+		return []styleFile{
+			{Path: "cmd/" + repository + "/main.go", Template: "templates/style/go/layout/service/main.go.gotxt"},
+			{Path: "cmd/" + repository + "/main_test.go", Template: "templates/style/go/layout/service/main_test.go.gotxt"},
+			{Path: fnGoVersion, Template: "templates/style/go/version.go.gotxt"},
+		}
+	default:
+		return []styleFile{
+			{Path: "cmd/" + repository + "/main.go", Template: "templates/style/go/layout/cli/main.go.gotxt"},
+			{Path: "cmd/" + repository + "/main_test.go", Template: "templates/style/go/layout/cli/main_test.go.gotxt"},
+			{Path: fnGoVersion, Template: "templates/style/go/version.go.gotxt"},
+		}
+	}
+}
+
+// goDynamicFiles returns layout files and release bundle when enabled.
+func goDynamicFiles(vars *readmeVariables) []styleFile {
+	files := goLayoutFiles(goLayout(vars.Go.Layout), vars.RepositoryName, vars.Go.Package)
+	if vars.Go.Release {
+		files = append(files, goReleaseFiles()...)
+	}
+
+	return files
+}
+
+// bumpVersionFiles returns bump-my-version entry of internal/version, library
+// layout has no version package.
+func (gv goVariables) bumpVersionFiles() []bumpVersionFile {
+	if goLayout(gv.Layout) == goLayoutLibrary {
+		return nil
+	}
+
+	return []bumpVersionFile{
+		{
+			Filename: path.Join(gv.Root, fnGoVersion),
+			Search:   `= "{current_version}"`,
+			Replace:  `= "{new_version}"`,
+		},
+	}
+}
+
+// goReleaseFiles returns goreleaser config and tag triggered release workflow.
+func goReleaseFiles() []styleFile {
+	return []styleFile{
+		{Path: fnGoReleaser, Template: "templates/style/go/release/goreleaser.yaml.gotxt"},
+		{Path: ".github/workflows/release.yml", Template: "templates/style/go/release/release.yml.gotxt"},
+	}
+}
+
+// goStyleVariables resolves layout, module path and go version from flags and
+// profile, go version falls back to the local toolchain. Module of a sub
+// folder root is a nested module, ex: github.com/vigo/repo/backend.
+func goStyleVariables(c *cli.Context, prof *profile, root string) (goVariables, error) {
+	layout := goLayout(c.String("go-layout"))
+	if _, ok := availableGoLayouts()[layout]; !ok {
+		keys := make([]string, 0, len(availableGoLayouts()))
+		for k := range availableGoLayouts() {
+			keys = append(keys, "`"+k.String()+"`")
+		}
+		sort.Strings(keys)
+
+		return goVariables{}, fmt.Errorf(
+			"%w `%s`. valid go layouts are: %s",
+			ErrInvalidGoLayout,
+			layout,
+			strings.Join(keys, ", "),
+		)
+	}
+
+	host := c.String("go-module-host")
+	if !c.IsSet("go-module-host") && prof.GoModuleHost != "" {
+		host = prof.GoModuleHost
+	}
+
+	module := c.String("go-module")
+	if module == "" {
+		module = strings.Join([]string{host, c.String("username"), c.String("repository-name")}, "/")
+		if root != "" {
+			module += "/" + root
+		}
+	}
+	if !reGoModule.MatchString(module) {
+		return goVariables{}, fmt.Errorf("%w `%s`", ErrInvalidGoModule, module)
+	}
+
+	goVersion := c.String("go-version")
+	if goVersion == "" {
+		var err error
+		if goVersion, err = localGoVersion(); err != nil {
+			return goVariables{}, fmt.Errorf("%w, set --go-version: %w", ErrInvalidGoVersion, err)
+		}
+	}
+	if !reGoVersion.MatchString(goVersion) {
+		return goVariables{}, fmt.Errorf("%w `%s`, ex: 1.25 or 1.25.0", ErrInvalidGoVersion, goVersion)
+	}
+
+	pkg := goPackageName(c.String("repository-name"))
+	if root != "" {
+		pkg = goPackageName(path.Base(root))
+	}
+
+	goVars := goVariables{
+		Module:  module,
+		Version: goVersion,
+		Layout:  layout.String(),
+		Package: pkg,
+		Root:    root,
+	}
+
+	if err := goVars.setRelease(c, prof); err != nil {
+		return goVariables{}, err
+	}
+
+	return goVars, nil
+}
+
+// setRelease enables the release bundle via --go-release or goreleaser
+// versioning, the workflow runs on tags pushed by bump-my-version or by hand.
+func (gv *goVariables) setRelease(c *cli.Context, prof *profile) error {
+	scheme := versioningScheme(c.String("versioning"))
+	gv.Release = c.Bool("go-release") || scheme == versioningGoreleaser
+	if !gv.Release {
+		return nil
+	}
+
+	if goLayout(gv.Layout) == goLayoutLibrary {
+		return ErrGoReleaseLayout
+	}
+	switch scheme {
+	case versioningSemver, versioningSemverPre, versioningCalver, versioningGoreleaser:
+	default:
+		return fmt.Errorf("%w, got `%s`", ErrGoReleaseVersioning, scheme)
+	}
+
+	tap := c.String("homebrew-tap")
+	if !c.IsSet("homebrew-tap") {
+		tap = prof.HomebrewTap
+	}
+	if tap == "" {
+		return nil
+	}
+
+	match := reGoTap.FindStringSubmatch(tap)
+	if match == nil {
+		return fmt.Errorf("%w `%s`, use OWNER/NAME", ErrInvalidHomebrewTap, tap)
+	}
+	gv.HomebrewTap = match[1] + "/" + match[2]
+	gv.HomebrewTapOwner = match[1]
+	gv.HomebrewTapRepository = "homebrew-" + match[2]
+
+	return nil
+}
+
+// localGoVersion returns go version of the local toolchain, ex: 1.25.0.
+func localGoVersion() (string, error) {
+	out, err := exec.Command("go", "env", "GOVERSION").Output()
+	if err != nil {
+		return "", fmt.Errorf("could not run go env, %w", err)
+	}
+
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("%w, go env GOVERSION is empty", ErrInvalidGoVersion)
+	}
+
+	goVersion, _, _ := strings.Cut(strings.TrimPrefix(fields[0], "go"), "-")
+
+	return goVersion, nil
+}
+
+// goPackageName derives a package name from repository name, go- prefix and
+// -go suffix are dropped, ex: go-hello-world becomes helloworld.
+func goPackageName(repository string) string {
+	name := strings.ToLower(repository)
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "go-"), "-go")
+	name = reGoPackage.ReplaceAllString(name, "")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "pkg" + name
+	}
+
+	return name
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// helmVariables holds helm style values used by chart files, chart-testing
+// config and workflows. Chart is generated under charts/<Chart>.
+type helmVariables struct {
+	Chart       string
+	Image       string
+	KubeVersion string
+	Root        string
+}
+
+const (
+	projectStyleHelm = projectStyle("helm")
+
+	helmKubeVersion = ">=1.28.0-0"
+)
+
+var reHelmChart = regexp.MustCompile(`[^a-z0-9]+`)
+
+func newHelmVariables(username, repository, root string) helmVariables {
+	chart := strings.Trim(reHelmChart.ReplaceAllString(strings.ToLower(repository), "-"), "-")
+	if chart == "" {
+		chart = "chart"
+	}
+
+	return helmVariables{
+		Chart:       chart,
+		Image:       strings.ToLower(dockerRegistry + "/" + username + "/" + repository),
+		KubeVersion: helmKubeVersion,
+		Root:        root,
+	}
+}
+
+// chartPath returns folder of the chart relative to style root.
+func (hv helmVariables) chartPath() string {
+	return path.Join("charts", hv.Chart)
+}
+
+// bumpVersionFiles returns bump-my-version entries for chart and app version
+// of Chart.yaml.
+func (hv helmVariables) bumpVersionFiles() []bumpVersionFile {
+	chartFile := path.Join(hv.Root, hv.chartPath(), "Chart.yaml")
+
+	return []bumpVersionFile{
+		{
+			Filename: chartFile,
+			Search:   "version: {current_version}",
+			Replace:  "version: {new_version}",
+		},
+		{
+			Filename: chartFile,
+			Search:   `appVersion: "{current_version}"`,
+			Replace:  `appVersion: "{new_version}"`,
+		},
+	}
+}
+
+// helmDynamicFiles returns chart files under charts/<chart> like `helm create`
+// generates, values.schema.json validates values.yaml.
+func helmDynamicFiles(vars *readmeVariables) []styleFile {
+	chartPath := vars.Helm.chartPath()
+	files := []styleFile{
+		{Path: "Chart.yaml", Template: "Chart.yaml.gotxt"},
+		{Path: "values.yaml", Template: "values.yaml.gotxt"},
+		{Path: "values.schema.json", Template: "values.schema.json.gotxt"},
+		{Path: ".helmignore", Template: "helmignore.gotxt"},
+		{Path: "templates/_helpers.tpl", Template: "templates/helpers.tpl.gotxt"},
+		{Path: "templates/deployment.yaml", Template: "templates/deployment.yaml.gotxt"},
+		{Path: "templates/service.yaml", Template: "templates/service.yaml.gotxt"},
+		{Path: "templates/serviceaccount.yaml", Template: "templates/serviceaccount.yaml.gotxt"},
+		{Path: "templates/NOTES.txt", Template: "templates/NOTES.txt.gotxt"},
+		{Path: "templates/tests/test-connection.yaml", Template: "templates/tests/test-connection.yaml.gotxt"},
+	}
+	for i := range files {
+		files[i].Path = path.Join(chartPath, files[i].Path)
+		files[i].Template = "templates/style/helm/chart/" + files[i].Template
+	}
+
+	return files
+}
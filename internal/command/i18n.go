@@ -0,0 +1,435 @@
+package command
+
+import (
+	_ "embed"
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+//go:embed templates/coc/tr/contributor-covenant-21.gotxt
+var templateCOCContributorCovenant21TR string
+
+type (
+	language  string
+	languages map[language]string
+
+	readmeTexts struct {
+		ProjectDescription string
+		Requirements       string
+		RequirementsBody   string
+		Installation       string
+		InstallationBody   string
+		Usage              string
+		UsageBody          string
+		API                string
+		Commands           string
+		Configuration      string
+		Deployment         string
+		Contributors       string
+		CreatorMaintainer  string
+		Contribute         string
+		ContributeWelcome  string
+		ContributeGuide    string
+		ContributeFork     string
+		ContributeBranch   string
+		ContributeCommit   string
+		ContributePush     string
+		ContributePR       string
+		License            string
+		LicensedUnder      string
+		DualLicensedUnder  string
+		COCNotice          string
+	}
+
+	readmeLanguageLink struct {
+		Name     string
+		FileName string
+	}
+)
+
+func (l language) String() string {
+	return string(l)
+}
+
+const (
+	languageEnglish = language("en")
+	languageTurkish = language("tr")
+)
+
+// sentinel errors.
+var ErrInvalidLanguage = errors.New("invalid language option")
+
+func availableLanguages() languages {
+	return languages{
+		languageEnglish: "English",
+		languageTurkish: "Türkçe",
+	}
+}
+
+func readmeTranslations() map[language]readmeTexts {
+	return map[language]readmeTexts{
+		languageEnglish: {
+			ProjectDescription: "Project description here...",
+			Requirements:       "Requirements",
+			RequirementsBody:   "Requirements here...",
+			Installation:       "Installation",
+			InstallationBody:   "Installation information here...",
+			Usage:              "Usage",
+			UsageBody:          "Usage information here...",
+			API:                "API",
+			Commands:           "Commands",
+			Configuration:      "Configuration",
+			Deployment:         "Deployment",
+			Contributors:       "Contributor(s)",
+			CreatorMaintainer:  "Creator, maintainer",
+			Contribute:         "Contribute",
+			ContributeWelcome:  "All PR’s are welcome!",
+			ContributeGuide:    "Please read [CONTRIBUTING.md](CONTRIBUTING.md) before opening a **Pull Request**.",
+			ContributeFork:     "`fork` (%s)",
+			ContributeBranch:   "Create your `branch` (`git checkout -b my-feature`)",
+			ContributeCommit:   "`commit` yours (`git commit -am 'add some functionality'`)",
+			ContributePush:     "`push` your `branch` (`git push origin my-feature`)",
+			ContributePR:       "Than create a new **Pull Request**!",
+			License:            "License",
+			LicensedUnder:      "This project is licensed under %s (%s)",
+			DualLicensedUnder:  "This project is licensed under %s or %s, at your option.",
+			COCNotice: "This project is intended to be a safe, welcoming space for collaboration, and\n" +
+				"contributors are expected to adhere to the [code of conduct][coc].",
+		},
+		languageTurkish: {
+			ProjectDescription: "Proje açıklaması burada...",
+			Requirements:       "Gereksinimler",
+			RequirementsBody:   "Gereksinimler burada...",
+			Installation:       "Kurulum",
+			InstallationBody:   "Kurulum bilgileri burada...",
+			Usage:              "Kullanım",
+			UsageBody:          "Kullanım bilgileri burada...",
+			API:                "API",
+			Commands:           "Komutlar",
+			Configuration:      "Yapılandırma",
+			Deployment:         "Dağıtım",
+			Contributors:       "Katkıda Bulunan(lar)",
+			CreatorMaintainer:  "Yaratıcısı, bakımcısı",
+			Contribute:         "Katkıda Bulunun",
+			ContributeWelcome:  "Tüm PR’lara açığız!",
+			ContributeGuide:    "**Pull Request** açmadan önce lütfen [CONTRIBUTING.md](CONTRIBUTING.md) dosyasını okuyun.",
+			ContributeFork:     "`fork` edin (%s)",
+			ContributeBranch:   "Kendi `branch`’inizi oluşturun (`git checkout -b my-feature`)",
+			ContributeCommit:   "Değişikliklerinizi `commit` edin (`git commit -am 'add some functionality'`)",
+			ContributePush:     "`branch`’inizi `push` edin (`git push origin my-feature`)",
+			ContributePR:       "Sonra yeni bir **Pull Request** açın!",
+			License:            "Lisans",
+			LicensedUnder:      "Bu proje %s (%s) ile lisanslanmıştır",
+			DualLicensedUnder:  "Bu proje, tercihinize bağlı olarak %s veya %s ile lisanslanmıştır.",
+			COCNotice: "Bu proje, iş birliği için güvenli ve misafirperver bir alan olmayı amaçlar;\n" +
+				"katkıda bulunanların [davranış kurallarına][coc] uyması beklenir.",
+		},
+	}
+}
+
+func localizedCOCTemplates() map[language]map[cocType]string {
+	return map[language]map[cocType]string{
+		languageTurkish: {
+			cocContributorCovenant21: templateCOCContributorCovenant21TR,
+		},
+	}
+}
+
+// cocTemplateFor returns the code of conduct template for given language,
+// second return value is false when there is no translation.
+func cocTemplateFor(ct cocType, lang language) (string, bool) {
+	if lang == languageEnglish {
+		tmpl, ok := cocTemplates()[ct]
+
+		return tmpl, ok
+	}
+
+	tmpl, ok := localizedCOCTemplates()[lang][ct]
+
+	return tmpl, ok
+}
+
+// localizedFileName returns the file name for given language. Primary language
+// keeps the original name, others get the language code before the extension,
+// README.md becomes README.tr.md.
+func localizedFileName(fileName string, lang language, primary bool) string {
+	if primary {
+		return fileName
+	}
+
+	ext := filepath.Ext(fileName)
+
+	return strings.TrimSuffix(fileName, ext) + "." + lang.String() + ext
+}
+
+type (
+	// pullRequestTexts holds texts of pull request templates, templates are
+	// rendered in the primary language. Issue keywords stay English since
+	// GitHub only recognizes them.
+	pullRequestTexts struct {
+		Description         string
+		DescriptionHint     string
+		RelatedIssue        string
+		IssueRequired       string
+		IssueOptional       string
+		TypeOfChange        string
+		TypeBugFix          string
+		TypeFeature         string
+		TypeBreaking        string
+		TypeDocs            string
+		Checklist           string
+		CheckLinked         string
+		CheckTests          string
+		CheckDocs           string
+		CheckChangelog      string
+		CheckChangeset      string
+		CheckConventional   string
+		Feature             string
+		FeatureHint         string
+		Motivation          string
+		MotivationHint      string
+		Tested              string
+		TestedHint          string
+		BackwardCompatible  string
+		Bug                 string
+		BugHint             string
+		RootCause           string
+		RootCauseHint       string
+		Fix                 string
+		FixHint             string
+		RegressionTest      string
+		Release             string
+		ReleaseVersion      string
+		Changes             string
+		ChangesHint         string
+		ReleaseChecklist    string
+		ReleaseBumped       string
+		ReleasePlease       string
+		ReleaseChangesets   string
+		ReleaseVersionFiles string
+		ReleaseChangelog    string
+		ReleaseDocs         string
+		ReleaseNotes        string
+	}
+
+	// issueFormTexts holds texts of issue forms, labels stay English to match
+	// default labels of GitHub.
+	issueFormTexts struct {
+		BugReport          string
+		BugReportAbout     string
+		BugTitle           string
+		BugIntro           string
+		Description        string
+		DescriptionHint    string
+		Steps              string
+		Expected           string
+		Actual             string
+		Version            string
+		VersionHint        string
+		Logs               string
+		LogsHint           string
+		FeatureRequest     string
+		FeatureAbout       string
+		FeatureTitle       string
+		Problem            string
+		ProblemHint        string
+		ProblemPlaceholder string
+		Solution           string
+		SolutionHint       string
+		Alternatives       string
+		AlternativesHint   string
+		Contribution       string
+		ContributionOption string
+		Question           string
+		QuestionAbout      string
+		QuestionTitle      string
+		QuestionIntro      string
+		QuestionHint       string
+		Context            string
+		ContextHint        string
+		Discussions        string
+		DiscussionsAbout   string
+		Security           string
+		SecurityAbout      string
+	}
+)
+
+func pullRequestTranslations() map[language]pullRequestTexts {
+	return map[language]pullRequestTexts{
+		languageEnglish: {
+			Description:         "Description",
+			DescriptionHint:     "What does this pull request change and why?",
+			RelatedIssue:        "Related Issue",
+			IssueRequired:       "Required: link the issue this pull request resolves.",
+			IssueOptional:       "Optional: link the issue this pull request resolves.",
+			TypeOfChange:        "Type of Change",
+			TypeBugFix:          "Bug fix",
+			TypeFeature:         "New feature",
+			TypeBreaking:        "Breaking change",
+			TypeDocs:            "Documentation",
+			Checklist:           "Checklist",
+			CheckLinked:         "This pull request is linked to an issue",
+			CheckTests:          "I have added tests that prove my fix is effective or that my feature works",
+			CheckDocs:           "I have updated the documentation",
+			CheckChangelog:      "I have added an entry under `Unreleased` in `CHANGELOG.md`",
+			CheckChangeset:      "I have added a changeset (`npx changeset`)",
+			CheckConventional:   "Pull request title follows Conventional Commits",
+			Feature:             "Feature",
+			FeatureHint:         "Describe the feature this pull request adds.",
+			Motivation:          "Motivation",
+			MotivationHint:      "Why is this feature needed? Which use case does it solve?",
+			Tested:              "How Has This Been Tested?",
+			TestedHint:          "Describe the tests you ran and how to reproduce them.",
+			BackwardCompatible:  "This change is backward compatible",
+			Bug:                 "Bug",
+			BugHint:             "What was wrong? Steps to reproduce if there is no issue.",
+			RootCause:           "Root Cause",
+			RootCauseHint:       "Why did it happen?",
+			Fix:                 "Fix",
+			FixHint:             "How does this pull request fix it?",
+			RegressionTest:      "I have added a regression test",
+			Release:             "Release",
+			ReleaseVersion:      "Version: <!-- ex: 1.2.0 -->",
+			Changes:             "Changes",
+			ChangesHint:         "Summary of changes included in this release.",
+			ReleaseChecklist:    "Release Checklist",
+			ReleaseBumped:       "Version is bumped with `bump-my-version bump <major|minor|patch>`",
+			ReleasePlease:       "This is the release pull request opened by release-please",
+			ReleaseChangesets:   "This is the version packages pull request opened by changesets",
+			ReleaseVersionFiles: "Version is updated in every version file",
+			ReleaseChangelog:    "`CHANGELOG.md` has the new version heading and compare link",
+			ReleaseDocs:         "Documentation reflects the release",
+			ReleaseNotes:        "Release notes are ready",
+		},
+		languageTurkish: {
+			Description:         "Açıklama",
+			DescriptionHint:     "Bu pull request neyi ve neden değiştiriyor?",
+			RelatedIssue:        "İlgili Issue",
+			IssueRequired:       "Zorunlu: bu pull request’in çözdüğü issue’yu bağlayın.",
+			IssueOptional:       "İsteğe bağlı: bu pull request’in çözdüğü issue’yu bağlayın.",
+			TypeOfChange:        "Değişiklik Türü",
+			TypeBugFix:          "Hata düzeltmesi",
+			TypeFeature:         "Yeni özellik",
+			TypeBreaking:        "Geriye dönük uyumsuz değişiklik",
+			TypeDocs:            "Dokümantasyon",
+			Checklist:           "Kontrol Listesi",
+			CheckLinked:         "Bu pull request bir issue’ya bağlı",
+			CheckTests:          "Düzeltmemin etkili olduğunu ya da özelliğimin çalıştığını kanıtlayan testler ekledim",
+			CheckDocs:           "Dokümantasyonu güncelledim",
+			CheckChangelog:      "`CHANGELOG.md` dosyasında `Unreleased` altına bir kayıt ekledim",
+			CheckChangeset:      "Bir changeset ekledim (`npx changeset`)",
+			CheckConventional:   "Pull request başlığı Conventional Commits kurallarına uyuyor",
+			Feature:             "Özellik",
+			FeatureHint:         "Bu pull request’in eklediği özelliği anlatın.",
+			Motivation:          "Motivasyon",
+			MotivationHint:      "Bu özelliğe neden ihtiyaç var? Hangi kullanım senaryosunu çözüyor?",
+			Tested:              "Nasıl Test Edildi?",
+			TestedHint:          "Çalıştırdığınız testleri ve nasıl tekrarlanacaklarını anlatın.",
+			BackwardCompatible:  "Bu değişiklik geriye dönük uyumlu",
+			Bug:                 "Hata",
+			BugHint:             "Sorun neydi? Issue yoksa hatayı tekrar üretme adımlarını yazın.",
+			RootCause:           "Kök Neden",
+			RootCauseHint:       "Neden oldu?",
+			Fix:                 "Düzeltme",
+			FixHint:             "Bu pull request sorunu nasıl düzeltiyor?",
+			RegressionTest:      "Bir regresyon testi ekledim",
+			Release:             "Yayın",
+			ReleaseVersion:      "Sürüm: <!-- örn: 1.2.0 -->",
+			Changes:             "Değişiklikler",
+			ChangesHint:         "Bu yayındaki değişikliklerin özeti.",
+			ReleaseChecklist:    "Yayın Kontrol Listesi",
+			ReleaseBumped:       "Sürüm `bump-my-version bump <major|minor|patch>` ile artırıldı",
+			ReleasePlease:       "Bu, release-please tarafından açılan yayın pull request’i",
+			ReleaseChangesets:   "Bu, changesets tarafından açılan version packages pull request’i",
+			ReleaseVersionFiles: "Sürüm tüm sürüm dosyalarında güncellendi",
+			ReleaseChangelog:    "`CHANGELOG.md` yeni sürüm başlığını ve karşılaştırma bağlantısını içeriyor",
+			ReleaseDocs:         "Dokümantasyon bu yayını yansıtıyor",
+			ReleaseNotes:        "Yayın notları hazır",
+		},
+	}
+}
+
+func issueFormTranslations() map[language]issueFormTexts {
+	return map[language]issueFormTexts{
+		languageEnglish: {
+			BugReport:      "Bug Report",
+			BugReportAbout: "Report a bug of %s",
+			BugTitle:       "[Bug]: ",
+			BugIntro: "Thanks for taking the time to fill out this bug report! " +
+				"Please search existing issues before creating a new one.",
+			Description:        "Description",
+			DescriptionHint:    "A clear and concise description of the bug.",
+			Steps:              "Steps to Reproduce",
+			Expected:           "Expected Behavior",
+			Actual:             "Actual Behavior",
+			Version:            "Version",
+			VersionHint:        "Version of %s you are using.",
+			Logs:               "Logs",
+			LogsHint:           "Relevant log output, will be formatted as code.",
+			FeatureRequest:     "Feature Request",
+			FeatureAbout:       "Suggest an idea for %s",
+			FeatureTitle:       "[Feature]: ",
+			Problem:            "Problem",
+			ProblemHint:        "Is your feature request related to a problem? Please describe.",
+			ProblemPlaceholder: "I'm always frustrated when ...",
+			Solution:           "Solution",
+			SolutionHint:       "Describe the solution you'd like.",
+			Alternatives:       "Alternatives",
+			AlternativesHint:   "Describe alternative solutions or features you've considered.",
+			Contribution:       "Contribution",
+			ContributionOption: "I'm willing to open a pull request for this feature",
+			Question:           "Question",
+			QuestionAbout:      "Ask a question about %s",
+			QuestionTitle:      "[Question]: ",
+			QuestionIntro:      "Open ended discussions fit better to [Discussions](%s).",
+			QuestionHint:       "What would you like to know?",
+			Context:            "Context",
+			ContextHint:        "What have you tried so far?",
+			Discussions:        "Discussions",
+			DiscussionsAbout:   "Ask questions and share ideas with the community.",
+			Security:           "Security Vulnerability",
+			SecurityAbout:      "Please report security vulnerabilities privately, see SECURITY.md.",
+		},
+		languageTurkish: {
+			BugReport:      "Hata Bildirimi",
+			BugReportAbout: "%s için hata bildirin",
+			BugTitle:       "[Hata]: ",
+			BugIntro: "Hata bildirimi için zaman ayırdığınız için teşekkürler! " +
+				"Yenisini açmadan önce lütfen mevcut issue’ları arayın.",
+			Description:        "Açıklama",
+			DescriptionHint:    "Hatanın açık ve kısa bir açıklaması.",
+			Steps:              "Tekrar Üretme Adımları",
+			Expected:           "Beklenen Davranış",
+			Actual:             "Gerçekleşen Davranış",
+			Version:            "Sürüm",
+			VersionHint:        "Kullandığınız %s sürümü.",
+			Logs:               "Loglar",
+			LogsHint:           "İlgili log çıktısı, kod olarak biçimlendirilir.",
+			FeatureRequest:     "Özellik İsteği",
+			FeatureAbout:       "%s için bir fikir önerin",
+			FeatureTitle:       "[Özellik]: ",
+			Problem:            "Sorun",
+			ProblemHint:        "Özellik isteğiniz bir sorunla mı ilgili? Lütfen anlatın.",
+			ProblemPlaceholder: "Şu durumda hep zorlanıyorum ...",
+			Solution:           "Çözüm",
+			SolutionHint:       "İstediğiniz çözümü anlatın.",
+			Alternatives:       "Alternatifler",
+			AlternativesHint:   "Düşündüğünüz alternatif çözümleri ya da özellikleri anlatın.",
+			Contribution:       "Katkı",
+			ContributionOption: "Bu özellik için pull request açmaya istekliyim",
+			Question:           "Soru",
+			QuestionAbout:      "%s hakkında soru sorun",
+			QuestionTitle:      "[Soru]: ",
+			QuestionIntro:      "Ucu açık tartışmalar için [Discussions](%s) daha uygundur.",
+			QuestionHint:       "Ne öğrenmek istiyorsunuz?",
+			Context:            "Bağlam",
+			ContextHint:        "Şimdiye kadar neler denediniz?",
+			Discussions:        "Tartışmalar",
+			DiscussionsAbout:   "Toplulukla soru sorun ve fikir paylaşın.",
+			Security:           "Güvenlik Açığı",
+			SecurityAbout: "Güvenlik açıklarını lütfen gizli olarak bildirin, " +
+				"SECURITY.md dosyasına bakın.",
+		},
+	}
+}
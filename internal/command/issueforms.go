@@ -0,0 +1,37 @@
+package command
+
+import "embed"
+
+//go:embed templates/issue
+var templateIssueForms embed.FS
+
+type (
+	// issueFormField is an additional input of bug report form, see GitHub
+	// form schema for valid types: input, textarea, dropdown.
+	issueFormField struct {
+		Type        string
+		ID          string
+		Label       string
+		Description string
+		Placeholder string
+		Options     []string
+		Required    bool
+	}
+
+	issueFormVariables struct {
+		*readmeVariables
+		IssueFields   []issueFormField
+		IssueFormText issueFormTexts
+	}
+)
+
+const dirIssueTemplate = ".github/ISSUE_TEMPLATE"
+
+func issueFormFiles() []styleFile {
+	return []styleFile{
+		{Path: dirIssueTemplate + "/bug_report.yml", Template: "templates/issue/bug_report.yml.gotxt"},
+		{Path: dirIssueTemplate + "/feature_request.yml", Template: "templates/issue/feature_request.yml.gotxt"},
+		{Path: dirIssueTemplate + "/question.yml", Template: "templates/issue/question.yml.gotxt"},
+		{Path: dirIssueTemplate + "/config.yml", Template: "templates/issue/config.yml.gotxt"},
+	}
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const licenseMatchThreshold = 0.8
+
+var reTemplateAction = regexp.MustCompile(`{{[^}]*}}`)
+
+func licenseTemplates() map[licenseType]string {
+	return map[licenseType]string{
+		licenseMIT:              templateLicenseMIT,
+		licenseMITNoAttribution: templateLicenseMITNA,
+		licenseGNUAfferoGPL30:   templateLicenseGNUAfferoGPL30,
+		licenseGNUGPL30:         templateLicenseGNUGPL30,
+		licenseGNULesserGPL30:   templateLicenseGNULesserGPL30,
+		licenseMOZP20:           templateLicenseMOZP20,
+		licenseAPACHE20:         templateLicenseAPACHE20,
+		licenseBSL10:            templateLicenseBSL10,
+		licenseTHEUNL:           templateLicenseTHEUNL,
+	}
+}
+
+var licenseTemplateBigrams = sync.OnceValue(func() map[licenseType]map[string]struct{} {
+	bigrams := make(map[licenseType]map[string]struct{}, len(licenseTemplates()))
+	for lt, text := range licenseTemplates() {
+		bigrams[lt] = licenseTextBigrams(reTemplateAction.ReplaceAllString(text, " "))
+	}
+
+	return bigrams
+})
+
+func licenseTextBigrams(text string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	bigrams := make(map[string]struct{}, len(words))
+	for i := 1; i < len(words); i++ {
+		bigrams[words[i-1]+" "+words[i]] = struct{}{}
+	}
+
+	return bigrams
+}
+
+// matchLicense finds the embedded license template closest to the given text.
+// It returns an empty license type when nothing scores above the threshold.
+func matchLicense(text string) (licenseType, float64) {
+	candidate := licenseTextBigrams(text)
+	if len(candidate) == 0 {
+		return "", 0
+	}
+
+	var (
+		best      licenseType
+		bestScore float64
+	)
+
+	for lt, bigrams := range licenseTemplateBigrams() {
+		var common int
+		for bigram := range bigrams {
+			if _, ok := candidate[bigram]; ok {
+				common++
+			}
+		}
+
+		score := 2 * float64(common) / float64(len(bigrams)+len(candidate))
+		if score > bestScore {
+			best, bestScore = lt, score
+		}
+	}
+
+	if bestScore < licenseMatchThreshold {
+		return "", bestScore
+	}
+
+	return best, bestScore
+}
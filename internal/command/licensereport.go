@@ -0,0 +1,342 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/build"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+const (
+	fnGoMod = "go.mod"
+
+	reportFormatTable = "table"
+	reportFormatJSON  = "json"
+
+	compatibilityOK           = "ok"
+	compatibilityReview       = "review"
+	compatibilityIncompatible = "incompatible"
+	compatibilityUnknown      = "unknown"
+)
+
+// sentinel errors.
+var (
+	ErrInvalidReportFormat           = errors.New("invalid report format")
+	ErrIncompatibleDependencyLicense = errors.New("incompatible dependency license found")
+)
+
+type (
+	goModule struct {
+		Path     string
+		Version  string
+		Indirect bool
+		Dir      string
+	}
+
+	dependencyLicense struct {
+		Module   string `json:"module"`
+		Version  string `json:"version"`
+		Indirect bool   `json:"indirect"`
+		License  string `json:"license"`
+		Status   string `json:"status"`
+		Note     string `json:"note,omitempty"`
+	}
+
+	dependencyLicenseReport struct {
+		Module       string              `json:"module"`
+		License      string              `json:"license"`
+		Dependencies []dependencyLicense `json:"dependencies"`
+	}
+)
+
+func (k *cmd) licenseReportAction() func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		argLicense := c.String("license")
+		if _, ok := availableLicenseTypes()[licenseType(argLicense)]; !ok {
+			return fmt.Errorf("%w `%s`", ErrInvalidLicense, argLicense)
+		}
+
+		argFormat := c.String("format")
+		if argFormat != reportFormatTable && argFormat != reportFormatJSON {
+			return fmt.Errorf(
+				"%w `%s`. valid formats are: `%s`, `%s`",
+				ErrInvalidReportFormat,
+				argFormat,
+				reportFormatTable,
+				reportFormatJSON,
+			)
+		}
+
+		dir := c.String("dir")
+		if dir == "" {
+			dir = k.cwd
+		}
+
+		report, err := buildDependencyLicenseReport(dir, licenseType(argLicense), goModCacheDir())
+		if err != nil {
+			return fmt.Errorf("could not build license report, %w", err)
+		}
+
+		wr := c.App.Writer
+		if argFormat == reportFormatJSON {
+			enc := json.NewEncoder(wr)
+			enc.SetIndent("", "  ")
+			if err = enc.Encode(report); err != nil {
+				return fmt.Errorf("could not encode license report, %w", err)
+			}
+		} else if err = writeDependencyLicenseTable(wr, report); err != nil {
+			return fmt.Errorf("could not write license report, %w", err)
+		}
+
+		for _, dep := range report.Dependencies {
+			if dep.Status == compatibilityIncompatible {
+				return ErrIncompatibleDependencyLicense
+			}
+		}
+
+		return nil
+	}
+}
+
+func goModCacheDir() string {
+	if modCache := os.Getenv("GOMODCACHE"); modCache != "" {
+		return modCache
+	}
+
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		gopath = build.Default.GOPATH
+	}
+
+	return filepath.Join(filepath.SplitList(gopath)[0], "pkg", "mod")
+}
+
+func buildDependencyLicenseReport(dir string, project licenseType, modCache string) (*dependencyLicenseReport, error) {
+	data, err := os.ReadFile(filepath.Join(dir, fnGoMod))
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s, %w", fnGoMod, err)
+	}
+
+	modulePath, modules, err := parseGoMod(data)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dependencyLicenseReport{
+		Module:       modulePath,
+		License:      project.String(),
+		Dependencies: make([]dependencyLicense, 0, len(modules)),
+	}
+
+	for _, mod := range modules {
+		dep := dependencyLicense{
+			Module:   mod.Path,
+			Version:  mod.Version,
+			Indirect: mod.Indirect,
+		}
+
+		moduleDir := mod.Dir
+		switch {
+		case moduleDir == "":
+			moduleDir, err = moduleCacheDir(modCache, mod.Path, mod.Version)
+			if err != nil {
+				return nil, err
+			}
+		case !filepath.IsAbs(moduleDir):
+			moduleDir = filepath.Join(dir, moduleDir)
+		}
+
+		detected, licenseFiles, found := detectModuleLicense(moduleDir)
+		switch {
+		case !found:
+			dep.Status = compatibilityUnknown
+			dep.Note = "not found in module cache"
+		case licenseFiles == 0:
+			dep.Status = compatibilityUnknown
+			dep.Note = "no license file found"
+		case detected == "":
+			dep.Status = compatibilityUnknown
+			dep.Note = "license text does not match any known license"
+		default:
+			dep.License = detected.String()
+			dep.Status, dep.Note = licenseCompatibility(project, detected)
+		}
+
+		report.Dependencies = append(report.Dependencies, dep)
+	}
+
+	return report, nil
+}
+
+func writeDependencyLicenseTable(wr io.Writer, report *dependencyLicenseReport) error {
+	tw := tabwriter.NewWriter(wr, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "MODULE\tVERSION\tLICENSE\tSTATUS\tNOTE\n")
+	for _, dep := range report.Dependencies {
+		license := dep.License
+		if license == "" {
+			license = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", dep.Module, dep.Version, license, dep.Status, dep.Note)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush table, %w", err)
+	}
+
+	return nil
+}
+
+// licenseCompatibility tells whether a dependency released under dependency
+// license can be combined into a project released under project license.
+func licenseCompatibility(project, dependency licenseType) (string, string) {
+	switch dependency {
+	case licenseGNUAfferoGPL30:
+		switch project {
+		case licenseGNUAfferoGPL30:
+			return compatibilityOK, ""
+		case licenseGNUGPL30:
+			return compatibilityReview, "AGPL terms still apply to the combined work over a network"
+		default:
+			return compatibilityIncompatible, "requires the project to be licensed under AGPL-3.0"
+		}
+
+	case licenseGNUGPL30:
+		switch project {
+		case licenseGNUGPL30, licenseGNUAfferoGPL30:
+			return compatibilityOK, ""
+		default:
+			return compatibilityIncompatible, "requires the project to be licensed under GPL-3.0"
+		}
+
+	case licenseGNULesserGPL30:
+		switch project {
+		case licenseGNULesserGPL30, licenseGNUGPL30, licenseGNUAfferoGPL30:
+			return compatibilityOK, ""
+		default:
+			return compatibilityReview, "static linking requires allowing users to relink"
+		}
+	}
+
+	return compatibilityOK, ""
+}
+
+// detectModuleLicense returns best matching license among license files of
+// the module and the number of license files found.
+func detectModuleLicense(dir string) (licenseType, int, bool) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", 0, false
+	}
+
+	var (
+		best         licenseType
+		bestScore    float64
+		licenseFiles int
+	)
+
+	for _, entry := range entries {
+		if entry.IsDir() || !isLicenseFileName(entry.Name()) {
+			continue
+		}
+		licenseFiles++
+
+		data, errRead := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if errRead != nil {
+			continue
+		}
+
+		lt, score := matchLicense(string(data))
+		if lt != "" && score > bestScore {
+			best, bestScore = lt, score
+		}
+	}
+
+	return best, licenseFiles, true
+}
+
+func isLicenseFileName(name string) bool {
+	name = strings.ToLower(name)
+	for _, prefix := range []string{"license", "licence", "copying", "unlicense"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// moduleCacheDir returns directory of the module in module cache, path and
+// version are case encoded by module.EscapePath and module.EscapeVersion.
+func moduleCacheDir(modCache, modulePath, version string) (string, error) {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return "", fmt.Errorf("could not escape module path, %w", err)
+	}
+
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return "", fmt.Errorf("could not escape module version, %w", err)
+	}
+
+	return filepath.Join(modCache, escapedPath+"@"+escapedVersion), nil
+}
+
+// parseGoMod returns the module path and required modules of a go.mod file,
+// replace directives are applied to the returned modules.
+func parseGoMod(data []byte) (string, []goModule, error) {
+	file, err := modfile.Parse(fnGoMod, data, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not parse %s, %w", fnGoMod, err)
+	}
+
+	var modulePath string
+	if file.Module != nil {
+		modulePath = file.Module.Mod.Path
+	}
+
+	modules := make([]goModule, 0, len(file.Require))
+	for _, req := range file.Require {
+		mod := goModule{Path: req.Mod.Path, Version: req.Mod.Version, Indirect: req.Indirect}
+
+		if replacement := goModReplacement(file.Replace, req.Mod); replacement != nil {
+			if replacement.Version == "" {
+				mod.Dir = replacement.Path
+			} else {
+				mod.Path, mod.Version = replacement.Path, replacement.Version
+			}
+		}
+
+		modules = append(modules, mod)
+	}
+
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Path < modules[j].Path })
+
+	return modulePath, modules, nil
+}
+
+// goModReplacement returns replacement of the module, a version specific
+// replace wins over the one for all versions.
+func goModReplacement(replaces []*modfile.Replace, mod module.Version) *module.Version {
+	var replacement *module.Version
+	for _, replace := range replaces {
+		switch replace.Old {
+		case mod:
+			return &replace.New
+		case module.Version{Path: mod.Path}:
+			replacement = &replace.New
+		}
+	}
+
+	return replacement
+}
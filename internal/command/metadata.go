@@ -0,0 +1,69 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vigo/git-init-githubrepo/internal/version"
+)
+
+const (
+	fnMetadata = ".git-init-githubrepo.json"
+
+	metadataGenerator = "git-init-githubrepo"
+)
+
+type (
+	// metadata keeps the choices made while generating the repository, future
+	// update commands rely on it to upgrade generated files.
+	metadata struct {
+		Generator        string                 `json:"generator"`
+		GeneratorVersion string                 `json:"generator_version"`
+		Languages        []string               `json:"languages"`
+		ProjectStyle     string                 `json:"project_style,omitempty"`
+		GoLayout         string                 `json:"go_layout,omitempty"`
+		TypeScript       bool                   `json:"typescript,omitempty"`
+		ActionType       string                 `json:"action_type,omitempty"`
+		Versioning       string                 `json:"versioning"`
+		CodeOfConduct    *metadataCodeOfConduct `json:"code_of_conduct,omitempty"`
+	}
+
+	metadataCodeOfConduct struct {
+		Type      string   `json:"type"`
+		Version   string   `json:"version,omitempty"`
+		Contacts  []string `json:"contacts"`
+		ReportURL string   `json:"report_url,omitempty"`
+	}
+)
+
+func newMetadata() *metadata {
+	return &metadata{
+		Generator:        metadataGenerator,
+		GeneratorVersion: version.Version,
+	}
+}
+
+// writeJSON writes v as indented JSON, metadata and codemeta.json use it.
+func (k *cmd) writeJSON(fileName string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode %s, %w", filepath.Base(fileName), err)
+	}
+	data = append(data, '\n')
+
+	if k.writer != nil {
+		if _, err = k.writer.Write(data); err != nil {
+			return fmt.Errorf("could not write %s, %w", filepath.Base(fileName), err)
+		}
+
+		return nil
+	}
+
+	if err = os.WriteFile(filepath.Clean(fileName), data, filePerm); err != nil {
+		return fmt.Errorf("could not write %s, %w", filepath.Base(fileName), err)
+	}
+
+	return nil
+}
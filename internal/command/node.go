@@ -0,0 +1,96 @@
+package command
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// nodeVariables holds node style values used by package.json, workflows and
+// package sources.
+type nodeVariables struct {
+	Package        string
+	TypeScript     bool
+	Version        string
+	MinimumVersion string
+	Versions       []string
+	Root           string
+}
+
+const (
+	projectStyleNode = projectStyle("node")
+
+	nodeVersion        = "24"
+	nodeMinimumVersion = "22.18.0"
+)
+
+var reNodePackage = regexp.MustCompile(`[^a-z0-9._~-]+`)
+
+// nodeSupportedVersions returns versions of the test matrix, minimum version
+// runs typescript tests without a build step.
+func nodeSupportedVersions() []string {
+	return []string{"22", "24"}
+}
+
+func newNodeVariables(repository, root string, typeScript bool) nodeVariables {
+	return nodeVariables{
+		Package:        nodePackageName(repository),
+		TypeScript:     typeScript,
+		Version:        nodeVersion,
+		MinimumVersion: nodeMinimumVersion,
+		Versions:       nodeSupportedVersions(),
+		Root:           root,
+	}
+}
+
+// nodePackageName derives a valid npm package name from repository name.
+func nodePackageName(repository string) string {
+	pkg := strings.TrimLeft(reNodePackage.ReplaceAllString(strings.ToLower(repository), "-"), "._-")
+	if pkg == "" {
+		pkg = "package"
+	}
+
+	return pkg
+}
+
+// programmingLanguage returns language of the package for codemeta.json.
+func (nv nodeVariables) programmingLanguage() string {
+	if nv.TypeScript {
+		return "TypeScript"
+	}
+
+	return "JavaScript"
+}
+
+// nodeDynamicFiles returns package sources and its first test, typescript
+// variant adds tsconfig.json.
+func nodeDynamicFiles(vars *readmeVariables) []styleFile {
+	ext := "js"
+	if vars.Node.TypeScript {
+		ext = "ts"
+	}
+
+	files := []styleFile{
+		{Path: "src/index." + ext, Template: "templates/style/node/index." + ext + ".gotxt"},
+		{Path: "test/index.test." + ext, Template: "templates/style/node/index.test.gotxt"},
+	}
+	if vars.Node.TypeScript {
+		files = append(files, styleFile{Path: "tsconfig.json", Template: "templates/style/node/tsconfig.json.gotxt"})
+	}
+
+	return files
+}
+
+// jsonString quotes s as a JSON string without escaping html characters, ex:
+// author field keeps <email> readable.
+func jsonString(s string) string {
+	var sb strings.Builder
+
+	enc := json.NewEncoder(&sb)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return `""`
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
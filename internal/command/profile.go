@@ -0,0 +1,45 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// profile holds reusable defaults loaded from a JSON file via --profile,
+// command-line flags take precedence over profile values.
+type profile struct {
+	Badges         []string                 `json:"badges"`
+	DisableBadges  []string                 `json:"disable_badges"`
+	ReadmeSections []readmeSection          `json:"readme_sections"`
+	Codeowners     []codeownersRule         `json:"codeowners"`
+	Funding        map[string]fundingValues `json:"funding"`
+
+	PullRequestTemplates []string `json:"pull_request_templates"`
+	RequireIssueLink     bool     `json:"require_issue_link"`
+
+	GoModuleHost string `json:"go_module_host"`
+	HomebrewTap  string `json:"homebrew_tap"`
+
+	ORCID       string `json:"orcid"`
+	Affiliation string `json:"affiliation"`
+}
+
+func loadProfile(fileName string) (*profile, error) {
+	prof := &profile{}
+	if fileName == "" {
+		return prof, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(fileName))
+	if err != nil {
+		return nil, fmt.Errorf("could not read profile, %w", err)
+	}
+
+	if err = json.Unmarshal(data, prof); err != nil {
+		return nil, fmt.Errorf("could not parse profile, %w", err)
+	}
+
+	return prof, nil
+}
@@ -0,0 +1,153 @@
+package command
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+//go:embed templates/pullrequest/checklist.gotxt
+var templatePullRequestChecklist string
+
+//go:embed templates/pullrequest/default.gotxt
+var templatePullRequestDefault string
+
+//go:embed templates/pullrequest/feature.gotxt
+var templatePullRequestFeature string
+
+//go:embed templates/pullrequest/bugfix.gotxt
+var templatePullRequestBugfix string
+
+//go:embed templates/pullrequest/release.gotxt
+var templatePullRequestRelease string
+
+//go:embed templates/pullrequest/issue-link.yml.gotxt
+var templatePullRequestIssueLink string
+
+type (
+	pullRequestTemplateType string
+
+	pullRequestTemplate struct {
+		Description  string
+		Template     string
+		IssueKeyword string
+	}
+
+	pullRequestVariables struct {
+		*readmeVariables
+		Checklist           []string
+		RequireIssueLink    bool
+		ConventionalCommits bool
+		IssueKeyword        string
+		PullRequestText     pullRequestTexts
+	}
+)
+
+func (pt pullRequestTemplateType) String() string {
+	return string(pt)
+}
+
+const (
+	fnPullRequestTemplate  = ".github/pull_request_template.md"
+	dirPullRequestTemplate = ".github/PULL_REQUEST_TEMPLATE"
+	fnIssueLinkWorkflow    = ".github/workflows/pr-issue-link.yml"
+
+	pullRequestTemplateFeature = pullRequestTemplateType("feature")
+	pullRequestTemplateBugfix  = pullRequestTemplateType("bugfix")
+	pullRequestTemplateRelease = pullRequestTemplateType("release")
+)
+
+// sentinel errors.
+var ErrInvalidPullRequestTemplate = errors.New("invalid pull request template option")
+
+func pullRequestTemplates() map[pullRequestTemplateType]pullRequestTemplate {
+	return map[pullRequestTemplateType]pullRequestTemplate{
+		pullRequestTemplateFeature: {
+			Description:  "new feature with motivation and test notes",
+			Template:     templatePullRequestFeature,
+			IssueKeyword: "Closes",
+		},
+		pullRequestTemplateBugfix: {
+			Description:  "bug fix with root cause and regression test",
+			Template:     templatePullRequestBugfix,
+			IssueKeyword: "Fixes",
+		},
+		pullRequestTemplateRelease: {
+			Description:  "release with version and changelog checks",
+			Template:     templatePullRequestRelease,
+			IssueKeyword: "Closes",
+		},
+	}
+}
+
+func validatePullRequestTemplates(names []string) error {
+	for _, name := range names {
+		if _, ok := pullRequestTemplates()[pullRequestTemplateType(name)]; !ok {
+			keys := make([]string, 0, len(pullRequestTemplates()))
+			for k := range pullRequestTemplates() {
+				keys = append(keys, "`"+k.String()+"`")
+			}
+			sort.Strings(keys)
+
+			return fmt.Errorf(
+				"%w `%s`. valid pull request templates are: %s",
+				ErrInvalidPullRequestTemplate,
+				name,
+				strings.Join(keys, ", "),
+			)
+		}
+	}
+
+	return nil
+}
+
+// generatePullRequestTemplates creates the default pull request template,
+// selected variants under PULL_REQUEST_TEMPLATE/ and the issue link workflow.
+func (k *cmd) generatePullRequestTemplates(targetFolder string, names []string, vars *pullRequestVariables) error {
+	type pullRequestFile struct {
+		Path string
+		pullRequestTemplate
+	}
+
+	files := []pullRequestFile{
+		{
+			Path:                fnPullRequestTemplate,
+			pullRequestTemplate: pullRequestTemplate{Template: templatePullRequestDefault, IssueKeyword: "Closes"},
+		},
+	}
+	for _, name := range names {
+		files = append(files, pullRequestFile{
+			Path:                dirPullRequestTemplate + "/" + name + ".md",
+			pullRequestTemplate: pullRequestTemplates()[pullRequestTemplateType(name)],
+		})
+	}
+
+	for _, file := range files {
+		vars.IssueKeyword = file.IssueKeyword
+
+		filePath := strings.Join(
+			[]string{targetFolder, file.Path},
+			string(os.PathSeparator),
+		)
+
+		if err := k.GenerateTextFromTemplate(filePath, vars, templatePullRequestChecklist+file.Template); err != nil {
+			return fmt.Errorf("could not generate %s file, %w", file.Path, err)
+		}
+	}
+
+	if vars.RequireIssueLink {
+		filePath := strings.Join(
+			[]string{targetFolder, fnIssueLinkWorkflow},
+			string(os.PathSeparator),
+		)
+
+		if err := k.GenerateTextFromTemplate(filePath, vars, templatePullRequestIssueLink); err != nil {
+			return fmt.Errorf("could not generate %s file, %w", fnIssueLinkWorkflow, err)
+		}
+	}
+
+	return nil
+}
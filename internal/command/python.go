@@ -0,0 +1,76 @@
+package command
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pythonVariables holds python style values used by pyproject.toml, package
+// files and workflows.
+type pythonVariables struct {
+	Package        string
+	Distribution   string
+	RequiresPython string
+	Versions       []string
+	LatestVersion  string
+	Root           string
+}
+
+const (
+	projectStylePython = projectStyle("python")
+
+	pythonRequiresVersion = "3.11"
+)
+
+var (
+	rePythonDistribution = regexp.MustCompile(`[-_.]+`)
+	rePythonPackage      = regexp.MustCompile(`[^a-z0-9_]`)
+)
+
+// pythonSupportedVersions returns versions of the test matrix, latest one
+// uploads coverage.
+func pythonSupportedVersions() []string {
+	return []string{"3.11", "3.12", "3.13", "3.14"}
+}
+
+func newPythonVariables(repository, root string) pythonVariables {
+	distribution := rePythonDistribution.ReplaceAllString(strings.ToLower(repository), "-")
+	versions := pythonSupportedVersions()
+
+	return pythonVariables{
+		Package:        pythonPackageName(distribution),
+		Distribution:   distribution,
+		RequiresPython: pythonRequiresVersion,
+		Versions:       versions,
+		LatestVersion:  versions[len(versions)-1],
+		Root:           root,
+	}
+}
+
+// pythonPackageName derives an importable package name from normalized
+// distribution name, python- and py- prefixes are dropped.
+func pythonPackageName(distribution string) string {
+	name := strings.TrimPrefix(strings.TrimPrefix(distribution, "python-"), "py-")
+	name = rePythonPackage.ReplaceAllString(strings.ReplaceAll(name, "-", "_"), "")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "pkg_" + name
+	}
+
+	return name
+}
+
+// pythonDynamicFiles returns src layout package and its first test. Under a
+// root, a README stub is added for pyproject.toml since packaging can not
+// refer files outside of the project folder; license files are left out.
+func pythonDynamicFiles(vars *readmeVariables) []styleFile {
+	files := []styleFile{
+		{Path: "src/" + vars.Python.Package + "/__init__.py", Template: "templates/style/python/init.py.gotxt"},
+		{Path: "src/" + vars.Python.Package + "/py.typed", Template: "templates/style/python/py.typed.gotxt"},
+		{Path: "tests/test_" + vars.Python.Package + ".py", Template: "templates/style/python/test.py.gotxt"},
+	}
+	if vars.Python.Root != "" {
+		files = append(files, styleFile{Path: "README.md", Template: "templates/style/python/readme.md.gotxt"})
+	}
+
+	return files
+}
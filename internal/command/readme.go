@@ -0,0 +1,193 @@
+package command
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/readme/requirements.gotxt
+var templateREADMERequirements string
+
+//go:embed templates/readme/installation.gotxt
+var templateREADMEInstallation string
+
+//go:embed templates/readme/usage.gotxt
+var templateREADMEUsage string
+
+//go:embed templates/readme/contributors.gotxt
+var templateREADMEContributors string
+
+//go:embed templates/readme/contribute.gotxt
+var templateREADMEContribute string
+
+//go:embed templates/readme/license.gotxt
+var templateREADMELicense string
+
+//go:embed templates/readme/coc.gotxt
+var templateREADMECOC string
+
+type (
+	// readmeSection declares a README section. Title and Body are templates
+	// executed with readmeVariables, Condition is a template pipeline and the
+	// section is skipped when it evaluates to false.
+	readmeSection struct {
+		Name      string `json:"name"`
+		Title     string `json:"title"`
+		Body      string `json:"body"`
+		Order     int    `json:"order"`
+		Condition string `json:"condition"`
+	}
+
+	renderedReadmeSection struct {
+		Title string
+		Body  string
+	}
+)
+
+const readmeSectionOrderStep = 10
+
+// sentinel errors.
+var ErrReadmeSectionNameRequired = errors.New("readme section name required")
+
+func defaultReadmeSections() []readmeSection {
+	return []readmeSection{
+		{Name: "requirements", Title: "{{.Text.Requirements}}", Body: templateREADMERequirements, Order: 10},
+		{Name: "installation", Title: "{{.Text.Installation}}", Body: templateREADMEInstallation, Order: 20},
+		{Name: "usage", Title: "{{.Text.Usage}}", Body: templateREADMEUsage, Order: 30},
+		{Name: "contributors", Title: "{{.Text.Contributors}}", Body: templateREADMEContributors, Order: 40},
+		{
+			Name:      "contribute",
+			Title:     "{{.Text.Contribute}}",
+			Body:      templateREADMEContribute,
+			Order:     50,
+			Condition: "or .AddContributing .AddForkInfo",
+		},
+		{
+			Name:      "license",
+			Title:     "{{.Text.License}}",
+			Body:      templateREADMELicense,
+			Order:     60,
+			Condition: ".AddLicense",
+		},
+		{Name: "coc", Body: templateREADMECOC, Order: 70, Condition: ".AddCOC"},
+	}
+}
+
+// mergeReadmeSections applies each layer on top of the previous one. Sections
+// are matched by name, non-empty fields override; new sections are appended
+// after the last one unless they declare an order.
+func mergeReadmeSections(layers ...[]readmeSection) []readmeSection {
+	var merged []readmeSection
+
+	for _, layer := range layers {
+		for _, section := range layer {
+			idx := slices.IndexFunc(merged, func(s readmeSection) bool { return s.Name == section.Name })
+			if idx == -1 {
+				if section.Order == 0 {
+					for _, s := range merged {
+						section.Order = max(section.Order, s.Order)
+					}
+					section.Order += readmeSectionOrderStep
+				}
+				merged = append(merged, section)
+
+				continue
+			}
+
+			if section.Title != "" {
+				merged[idx].Title = section.Title
+			}
+			if section.Body != "" {
+				merged[idx].Body = section.Body
+			}
+			if section.Order != 0 {
+				merged[idx].Order = section.Order
+			}
+			if section.Condition != "" {
+				merged[idx].Condition = section.Condition
+			}
+		}
+	}
+
+	slices.SortStableFunc(merged, func(a, b readmeSection) int { return a.Order - b.Order })
+
+	return merged
+}
+
+// validateReadmeSections parses section templates, so broken profile sections
+// fail before anything is created.
+func validateReadmeSections(sections []readmeSection) error {
+	for _, section := range sections {
+		if section.Name == "" {
+			return ErrReadmeSectionNameRequired
+		}
+
+		texts := []string{section.Title, section.Body}
+		if section.Condition != "" {
+			texts = append(texts, "{{if "+section.Condition+"}}{{end}}")
+		}
+
+		for _, text := range texts {
+			if _, err := template.New(section.Name).Funcs(templateFilters()).Parse(text); err != nil {
+				return fmt.Errorf("invalid %s section template, %w", section.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func renderReadmeSections(sections []readmeSection, vars *readmeVariables) ([]renderedReadmeSection, error) {
+	rendered := make([]renderedReadmeSection, 0, len(sections))
+
+	for _, section := range sections {
+		if section.Condition != "" {
+			ok, err := executeTemplateString(
+				section.Name+"-condition",
+				"{{if "+section.Condition+"}}true{{end}}",
+				vars,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("could not evaluate %s section condition, %w", section.Name, err)
+			}
+			if ok != "true" {
+				continue
+			}
+		}
+
+		title, err := executeTemplateString(section.Name+"-title", section.Title, vars)
+		if err != nil {
+			return nil, fmt.Errorf("could not render %s section title, %w", section.Name, err)
+		}
+
+		body, err := executeTemplateString(section.Name, section.Body, vars)
+		if err != nil {
+			return nil, fmt.Errorf("could not render %s section, %w", section.Name, err)
+		}
+
+		rendered = append(rendered, renderedReadmeSection{
+			Title: strings.TrimSpace(title),
+			Body:  strings.TrimSpace(body),
+		})
+	}
+
+	return rendered, nil
+}
+
+func executeTemplateString(name, text string, data any) (string, error) {
+	tmpl, err := template.New(name).Funcs(templateFilters()).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("could not parse template: %w", err)
+	}
+
+	var sb strings.Builder
+	if err = tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("could not execute template: %w", err)
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type (
+	rustCrateType  string
+	rustCrateTypes map[rustCrateType]string
+
+	// rustVariables holds rust style values used by Cargo.toml, deny.toml and
+	// crate sources.
+	rustVariables struct {
+		Crate           string
+		CrateIdent      string
+		CrateType       string
+		Edition         string
+		MSRV            string
+		AllowedLicenses []string
+		Root            string
+	}
+)
+
+func (rct rustCrateType) String() string {
+	return string(rct)
+}
+
+const (
+	projectStyleRust = projectStyle("rust")
+
+	rustCrateTypeBin = rustCrateType("bin")
+	rustCrateTypeLib = rustCrateType("lib")
+
+	rustEdition = "2024"
+	rustMSRV    = "1.85"
+)
+
+var reRustCrate = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// sentinel errors.
+var ErrInvalidRustCrateType = errors.New("invalid rust crate type option")
+
+func availableRustCrateTypes() rustCrateTypes {
+	return rustCrateTypes{
+		rustCrateTypeBin: "binary crate, src/main.rs",
+		rustCrateTypeLib: "library crate, src/lib.rs with a doc test",
+	}
+}
+
+// rustAllowedLicenses returns cargo-deny allow list, permissive licenses
+// common in crates.io dependencies and licenses of the project itself.
+func rustAllowedLicenses(lt licenseType) []string {
+	allowed := []string{"Apache-2.0", "BSD-2-Clause", "BSD-3-Clause", "ISC", "MIT", "Unicode-3.0", "Zlib"}
+	if lt.SPDX() != "" {
+		for _, id := range strings.Split(lt.SPDX(), " OR ") {
+			if !slices.Contains(allowed, id) {
+				allowed = append(allowed, id)
+			}
+		}
+	}
+	sort.Strings(allowed)
+
+	return allowed
+}
+
+func newRustVariables(crateType, repository, root string, lt licenseType) (rustVariables, error) {
+	if _, ok := availableRustCrateTypes()[rustCrateType(crateType)]; !ok {
+		keys := make([]string, 0, len(availableRustCrateTypes()))
+		for k := range availableRustCrateTypes() {
+			keys = append(keys, "`"+k.String()+"`")
+		}
+		sort.Strings(keys)
+
+		return rustVariables{}, fmt.Errorf(
+			"%w `%s`. valid rust crate types are: %s",
+			ErrInvalidRustCrateType,
+			crateType,
+			strings.Join(keys, ", "),
+		)
+	}
+
+	crate := strings.Trim(reRustCrate.ReplaceAllString(strings.ToLower(repository), "-"), "-_")
+	if crate == "" || (crate[0] >= '0' && crate[0] <= '9') {
+		crate = "crate-" + crate
+	}
+
+	return rustVariables{
+		Crate:           crate,
+		CrateIdent:      strings.ReplaceAll(crate, "-", "_"),
+		CrateType:       crateType,
+		Edition:         rustEdition,
+		MSRV:            rustMSRV,
+		AllowedLicenses: rustAllowedLicenses(lt),
+		Root:            root,
+	}, nil
+}
+
+// rustDynamicFiles returns src/main.rs or src/lib.rs according to crate type.
+func rustDynamicFiles(vars *readmeVariables) []styleFile {
+	if rustCrateType(vars.Rust.CrateType) == rustCrateTypeLib {
+		return []styleFile{{Path: "src/lib.rs", Template: "templates/style/rust/lib.rs.gotxt"}}
+	}
+
+	return []styleFile{{Path: "src/main.rs", Template: "templates/style/rust/main.rs.gotxt"}}
+}
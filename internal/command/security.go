@@ -0,0 +1,278 @@
+package command
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // OpenPGP v4 fingerprints are defined as SHA-1.
+	"crypto/sha256"
+	_ "embed"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+//go:embed templates/security.gotxt
+var templateSecurity string
+
+//go:embed templates/security.txt.gotxt
+var templateSecurityTXT string
+
+type (
+	supportedVersion struct {
+		Version   string
+		Supported bool
+	}
+
+	securityContact struct {
+		Name string
+		URI  string
+	}
+
+	securityVariables struct {
+		*readmeVariables
+		Website           string
+		Contacts          []securityContact
+		PrivateReporting  bool
+		SupportedVersions []supportedVersion
+		AcknowledgeDays   int
+		AssessmentDays    int
+		DisclosureDays    int
+		PGPFingerprint    string
+		PGPPublicKey      string
+		PGPEncryptionURI  string
+		Languages         []string
+		Expires           string
+	}
+)
+
+const (
+	fnSecurity    = "SECURITY.md"
+	fnSecurityTXT = ".well-known/security.txt"
+
+	securityAcknowledgeDays = 3
+	securityAssessmentDays  = 7
+	securityDisclosureDays  = 90
+
+	securityTXTExpiresMonths = 11
+
+	semanticVersionParts = 3
+
+	pgpArmorBegin       = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	pgpArmorEnd         = "-----END PGP PUBLIC KEY BLOCK-----"
+	pgpPacketPublicKey  = 6
+	pgpKeyVersion4      = 4
+	pgpKeyVersion6      = 6
+	pgpFingerprintGroup = 4
+)
+
+var reEmail = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// sentinel errors.
+var (
+	ErrInvalidSecurityContact = errors.New("invalid security contact")
+	ErrInvalidWebsite         = errors.New("invalid website url")
+	ErrInvalidPGPKey          = errors.New("invalid pgp public key")
+)
+
+func validHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// securityContacts converts emails and URLs to security.txt contact URIs.
+func securityContacts(contacts []string) ([]securityContact, error) {
+	result := make([]securityContact, 0, len(contacts))
+
+	for _, contact := range contacts {
+		switch {
+		case reEmail.MatchString(contact):
+			result = append(result, securityContact{Name: contact, URI: "mailto:" + contact})
+		case validHTTPURL(contact):
+			result = append(result, securityContact{Name: contact, URI: contact})
+		default:
+			return nil, fmt.Errorf("%w `%s`, use an email or http(s) url", ErrInvalidSecurityContact, contact)
+		}
+	}
+
+	return result, nil
+}
+
+// supportedVersions derives supported versions table from initial version,
+// latest major (minor for 0.x, month for calver) is supported.
+func supportedVersions(version string, scheme versioningScheme) []supportedVersion {
+	version, _, _ = strings.Cut(version, "-")
+	version, _, _ = strings.Cut(version, "+")
+
+	parts := strings.Split(version, ".")
+	if len(parts) != semanticVersionParts {
+		return nil
+	}
+
+	major, minor := parts[0], parts[1]
+	if scheme == versioningCalver || major == "0" {
+		versions := []supportedVersion{{Version: major + "." + minor + ".x", Supported: true}}
+		if scheme == versioningCalver || minor != "0" {
+			versions = append(versions, supportedVersion{Version: "< " + major + "." + minor})
+		}
+
+		return versions
+	}
+
+	return []supportedVersion{
+		{Version: major + ".x.x", Supported: true},
+		{Version: "< " + major + ".0"},
+	}
+}
+
+// readPGPPublicKey reads an ASCII armored OpenPGP public key and returns the
+// armored key and fingerprint of its primary key.
+func readPGPPublicKey(fileName string) (string, string, error) {
+	data, err := os.ReadFile(filepath.Clean(fileName))
+	if err != nil {
+		return "", "", fmt.Errorf("could not read pgp key, %w", err)
+	}
+
+	armored := strings.TrimSpace(string(data))
+	begin := strings.Index(armored, pgpArmorBegin)
+	end := strings.Index(armored, pgpArmorEnd)
+	if begin == -1 || end < begin {
+		return "", "", fmt.Errorf("%w, armored public key block not found", ErrInvalidPGPKey)
+	}
+	armored = armored[begin : end+len(pgpArmorEnd)]
+
+	var (
+		body      strings.Builder
+		inHeaders = true
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(armored[len(pgpArmorBegin) : len(armored)-len(pgpArmorEnd)]))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if inHeaders {
+			if line == "" {
+				inHeaders = false
+			}
+
+			continue
+		}
+		if strings.HasPrefix(line, "=") {
+			break
+		}
+		body.WriteString(line)
+	}
+
+	packets, err := base64.StdEncoding.DecodeString(body.String())
+	if err != nil {
+		return "", "", fmt.Errorf("%w, %w", ErrInvalidPGPKey, err)
+	}
+
+	fingerprint, err := pgpFingerprint(packets)
+	if err != nil {
+		return "", "", err
+	}
+
+	return armored, fingerprint, nil
+}
+
+// pgpFingerprint computes fingerprint of the first public key packet, see
+// RFC 9580 section 5.5.4.
+func pgpFingerprint(packets []byte) (string, error) {
+	if len(packets) < 2 {
+		return "", fmt.Errorf("%w, key packet is too short", ErrInvalidPGPKey)
+	}
+
+	var (
+		tag    byte
+		length int
+		offset int
+	)
+
+	header := packets[0]
+	switch {
+	case header&0x80 == 0:
+		return "", fmt.Errorf("%w, malformed packet header", ErrInvalidPGPKey)
+	case header&0x40 != 0: // new format
+		tag = header & 0x3f
+		switch first := int(packets[1]); {
+		case first < 192:
+			length, offset = first, 2
+		case first < 224 && len(packets) > 2:
+			length, offset = (first-192)<<8+int(packets[2])+192, 3
+		case first == 255 && len(packets) > 5:
+			length, offset = int(binary.BigEndian.Uint32(packets[2:6])), 6
+		default:
+			return "", fmt.Errorf("%w, unsupported packet length", ErrInvalidPGPKey)
+		}
+	default: // old format
+		tag = (header >> 2) & 0x0f
+		switch header & 0x03 {
+		case 0:
+			length, offset = int(packets[1]), 2
+		case 1:
+			if len(packets) < 3 {
+				return "", fmt.Errorf("%w, key packet is too short", ErrInvalidPGPKey)
+			}
+			length, offset = int(binary.BigEndian.Uint16(packets[1:3])), 3
+		case 2:
+			if len(packets) < 5 {
+				return "", fmt.Errorf("%w, key packet is too short", ErrInvalidPGPKey)
+			}
+			length, offset = int(binary.BigEndian.Uint32(packets[1:5])), 5
+		default:
+			return "", fmt.Errorf("%w, unsupported packet length", ErrInvalidPGPKey)
+		}
+	}
+
+	if tag != pgpPacketPublicKey {
+		return "", fmt.Errorf("%w, first packet is not a public key", ErrInvalidPGPKey)
+	}
+	if length == 0 || offset+length > len(packets) {
+		return "", fmt.Errorf("%w, key packet is truncated", ErrInvalidPGPKey)
+	}
+
+	key := packets[offset : offset+length]
+
+	var sum []byte
+	switch key[0] {
+	case pgpKeyVersion4:
+		h := sha1.New() //nolint:gosec // see import.
+		h.Write([]byte{0x99, byte(length >> 8), byte(length)})
+		h.Write(key)
+		sum = h.Sum(nil)
+	case pgpKeyVersion6:
+		h := sha256.New()
+		h.Write([]byte{0x9b})
+		h.Write(binary.BigEndian.AppendUint32(nil, uint32(length))) //nolint:gosec // length is at most 4 bytes.
+		h.Write(key)
+		sum = h.Sum(nil)
+	default:
+		return "", fmt.Errorf("%w, unsupported key version %d", ErrInvalidPGPKey, key[0])
+	}
+
+	hex := strings.ToUpper(fmt.Sprintf("%x", sum))
+
+	var groups []string
+	for i := 0; i < len(hex); i += pgpFingerprintGroup {
+		groups = append(groups, hex[i:i+pgpFingerprintGroup])
+	}
+
+	return strings.Join(groups, " "), nil
+}
+
+// securityTXTExpires returns Expires field value, RFC 9116 recommends less
+// than a year.
+func securityTXTExpires(now time.Time) string {
+	return now.AddDate(0, securityTXTExpiresMonths, 0).UTC().Truncate(24 * time.Hour).Format(time.RFC3339)
+}
+
+// compactFingerprint returns fingerprint without spaces for openpgp4fpr URIs.
+func compactFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(fingerprint, " ", ""))
+}
@@ -0,0 +1,619 @@
+package command
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+//go:embed templates/style
+var templateStyles embed.FS
+
+//go:embed templates/style/go/readme-installation.gotxt
+var templateGoReadmeInstallation string
+
+//go:embed templates/style/go/readme-api.gotxt
+var templateGoReadmeAPI string
+
+//go:embed templates/style/go/readme-commands.gotxt
+var templateGoReadmeCommands string
+
+//go:embed templates/style/go/readme-configuration.gotxt
+var templateGoReadmeConfiguration string
+
+//go:embed templates/style/go/readme-deployment.gotxt
+var templateGoReadmeDeployment string
+
+//go:embed templates/style/python/readme-installation.gotxt
+var templatePythonReadmeInstallation string
+
+//go:embed templates/style/rust/readme-installation.gotxt
+var templateRustReadmeInstallation string
+
+//go:embed templates/style/node/readme-installation.gotxt
+var templateNodeReadmeInstallation string
+
+//go:embed templates/style/docker/readme-installation.gotxt
+var templateDockerReadmeInstallation string
+
+//go:embed templates/style/action/readme-usage.gotxt
+var templateActionReadmeUsage string
+
+//go:embed templates/style/terraform/readme-usage.gotxt
+var templateTerraformReadmeUsage string
+
+//go:embed templates/style/helm/readme-installation.gotxt
+var templateHelmReadmeInstallation string
+
+type (
+	styleFile struct {
+		Path     string
+		Template string
+	}
+
+	styleWorkflow struct {
+		Name     string
+		FileName string
+	}
+
+	projectStyleDefinition struct {
+		Title          string
+		Files          []styleFile
+		Workflows      []styleWorkflow
+		Badges         []badgeType
+		ReadmeSections []readmeSection
+		VersionFiles   []bumpVersionFile
+
+		DevelopmentCommands []developmentCommand
+		EnvironmentCommands []string
+		IssueFields         []issueFormField
+		PullRequestChecks   []string
+		ProgrammingLanguage string
+		DefaultLicense      licenseType
+
+		// Dependabot and PreCommit are merged with other styles, see
+		// generateStyleFiles.
+		Dependabot []dependabotUpdate
+		PreCommit  []preCommitRepo
+
+		// DynamicFiles returns files depending on style options, ex: paths
+		// derived from package name.
+		DynamicFiles func(vars *readmeVariables) []styleFile
+	}
+)
+
+// sentinel errors.
+var ErrInvalidProjectStyle = errors.New("invalid project style option")
+
+func projectStyleDefinitions() map[projectStyle]projectStyleDefinition {
+	return map[projectStyle]projectStyleDefinition{
+		projectStyleGo: {
+			Title: "Go",
+			Files: []styleFile{
+				{Path: "go.mod", Template: "templates/style/go/go.mod.gotxt"},
+				{Path: ".github/workflows/go-test.yml", Template: "templates/style/go/go-test.yml.gotxt"},
+				{Path: ".github/workflows/go-lint.yml", Template: "templates/style/go/go-lint.yml.gotxt"},
+				{Path: ".golangci.yml", Template: "templates/style/go/golangci.yml.gotxt"},
+				{Path: ".codecov.yml", Template: "templates/style/codecov.yml.gotxt"},
+				{Path: ".gitignore", Template: "templates/style/go/gitignore.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "go test", FileName: "go-test.yml"},
+				{Name: "go lint", FileName: "go-lint.yml"},
+			},
+			Badges: []badgeType{
+				badgeGoVersion,
+				badgeCodecov,
+				badgeGoReportCard,
+				badgePkgGoDev,
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "installation", Body: templateGoReadmeInstallation},
+				{
+					Name:      "api",
+					Title:     "{{.Text.API}}",
+					Body:      templateGoReadmeAPI,
+					Order:     35,
+					Condition: `eq .Go.Layout "library"`,
+				},
+				{
+					Name:      "commands",
+					Title:     "{{.Text.Commands}}",
+					Body:      templateGoReadmeCommands,
+					Order:     35,
+					Condition: `eq .Go.Layout "cli"`,
+				},
+				{
+					Name:      "configuration",
+					Title:     "{{.Text.Configuration}}",
+					Body:      templateGoReadmeConfiguration,
+					Order:     33,
+					Condition: `eq .Go.Layout "service"`,
+				},
+				{
+					Name:      "deployment",
+					Title:     "{{.Text.Deployment}}",
+					Body:      templateGoReadmeDeployment,
+					Order:     36,
+					Condition: `eq .Go.Layout "service"`,
+				},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "run tests", Command: "go test -race ./..."},
+				{Description: "run linter", Command: "golangci-lint run"},
+			},
+			EnvironmentCommands: []string{"go version", "go env GOOS GOARCH"},
+			ProgrammingLanguage: "Go",
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "gomod",
+					Interval:  "daily",
+					Label:     "gomod",
+					Prefix:    "[gomod] - upgrade go dependencies",
+				},
+			},
+			PreCommit: []preCommitRepo{
+				{
+					Repo:  "https://github.com/TekWizely/pre-commit-golang",
+					Rev:   "v1.0.0-rc.1",
+					Hooks: []preCommitHook{{ID: "golangci-lint-mod"}, {ID: "go-mod-tidy"}},
+				},
+			},
+			DynamicFiles: goDynamicFiles,
+			PullRequestChecks: []string{
+				"`go test -race ./...` passes",
+				"`golangci-lint run` reports no issues",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "go-version",
+					Label:       "Go Version",
+					Description: "Output of `go version`",
+					Placeholder: "go version go1.25.0 linux/amd64",
+					Required:    true,
+				},
+				{
+					Type:     "dropdown",
+					ID:       "os",
+					Label:    "Operating System",
+					Options:  []string{"Linux", "macOS", "Windows", "Other"},
+					Required: true,
+				},
+			},
+		},
+		projectStylePython: {
+			Title: "Python",
+			Files: []styleFile{
+				{Path: "pyproject.toml", Template: "templates/style/python/pyproject.toml.gotxt"},
+				{Path: "ruff.toml", Template: "templates/style/python/ruff.toml.gotxt"},
+				{Path: ".github/workflows/python-test.yml", Template: "templates/style/python/python-test.yml.gotxt"},
+				{Path: ".github/workflows/python-lint.yml", Template: "templates/style/python/python-lint.yml.gotxt"},
+				{Path: ".codecov.yml", Template: "templates/style/codecov.yml.gotxt"},
+				{Path: ".gitignore", Template: "templates/style/python/gitignore.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "python test", FileName: "python-test.yml"},
+				{Name: "python lint", FileName: "python-lint.yml"},
+			},
+			Badges: []badgeType{
+				badgePythonVersion,
+				badgeCodecov,
+				badgeRuff,
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "installation", Body: templatePythonReadmeInstallation},
+			},
+			VersionFiles: []bumpVersionFile{
+				{
+					Filename: "pyproject.toml",
+					Search:   `version = "{current_version}"`,
+					Replace:  `version = "{new_version}"`,
+				},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "create virtual environment", Command: "python -m venv .venv && source .venv/bin/activate"},
+				{Description: "install development dependencies", Command: `pip install -e ".[dev]"`},
+				{Description: "run tests", Command: "pytest"},
+				{Description: "run linter", Command: "ruff check . && ruff format --check ."},
+			},
+			EnvironmentCommands: []string{"python --version", "pip --version"},
+			ProgrammingLanguage: "Python",
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "pip",
+					Interval:  "daily",
+					Label:     "pip",
+					Prefix:    "[pip] - upgrade python dependencies",
+				},
+			},
+			PreCommit: []preCommitRepo{
+				{
+					Repo:  "https://github.com/astral-sh/ruff-pre-commit",
+					Rev:   "v0.14.0",
+					Hooks: []preCommitHook{{ID: "ruff-check", Args: []string{"--fix"}}, {ID: "ruff-format"}},
+				},
+			},
+			DynamicFiles: pythonDynamicFiles,
+			PullRequestChecks: []string{
+				"`pytest` passes",
+				"`ruff check .` and `ruff format --check .` report no issues",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "python-version",
+					Label:       "Python Version",
+					Description: "Output of `python --version`",
+					Placeholder: "Python 3.13.0",
+					Required:    true,
+				},
+				{
+					Type:     "dropdown",
+					ID:       "os",
+					Label:    "Operating System",
+					Options:  []string{"Linux", "macOS", "Windows", "Other"},
+					Required: true,
+				},
+			},
+		},
+		projectStyleRust: {
+			Title: "Rust",
+			Files: []styleFile{
+				{Path: "Cargo.toml", Template: "templates/style/rust/Cargo.toml.gotxt"},
+				{Path: "rustfmt.toml", Template: "templates/style/rust/rustfmt.toml.gotxt"},
+				{Path: "clippy.toml", Template: "templates/style/rust/clippy.toml.gotxt"},
+				{Path: "deny.toml", Template: "templates/style/rust/deny.toml.gotxt"},
+				{Path: ".github/workflows/rust-ci.yml", Template: "templates/style/rust/rust-ci.yml.gotxt"},
+				{Path: ".gitignore", Template: "templates/style/rust/gitignore.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "rust ci", FileName: "rust-ci.yml"},
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "installation", Body: templateRustReadmeInstallation},
+			},
+			VersionFiles: []bumpVersionFile{
+				{
+					Filename: "Cargo.toml",
+					Search:   `version = "{current_version}"`,
+					Replace:  `version = "{new_version}"`,
+				},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "run tests", Command: "cargo test"},
+				{Description: "run linter", Command: "cargo clippy --all-targets -- -D warnings"},
+				{Description: "format code", Command: "cargo fmt --all"},
+				{Description: "check dependencies", Command: "cargo deny check"},
+			},
+			EnvironmentCommands: []string{"rustc --version", "cargo --version"},
+			ProgrammingLanguage: "Rust",
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "cargo",
+					Interval:  "daily",
+					Label:     "cargo",
+					Prefix:    "[cargo] - upgrade rust dependencies",
+				},
+			},
+			DefaultLicense: licenseMITApache20,
+			DynamicFiles:   rustDynamicFiles,
+			PullRequestChecks: []string{
+				"`cargo test` passes",
+				"`cargo clippy --all-targets -- -D warnings` reports no issues",
+				"`cargo fmt --all --check` passes",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "rust-version",
+					Label:       "Rust Version",
+					Description: "Output of `rustc --version`",
+					Placeholder: "rustc 1.90.0 (1159e78c4 2025-09-14)",
+					Required:    true,
+				},
+				{
+					Type:     "dropdown",
+					ID:       "os",
+					Label:    "Operating System",
+					Options:  []string{"Linux", "macOS", "Windows", "Other"},
+					Required: true,
+				},
+			},
+		},
+		projectStyleNode: {
+			Title: "Node.js",
+			Files: []styleFile{
+				{Path: "package.json", Template: "templates/style/node/package.json.gotxt"},
+				{Path: "eslint.config.js", Template: "templates/style/node/eslint.config.js.gotxt"},
+				{Path: ".prettierrc.json", Template: "templates/style/node/prettierrc.json.gotxt"},
+				{Path: ".prettierignore", Template: "templates/style/node/prettierignore.gotxt"},
+				{Path: ".nvmrc", Template: "templates/style/node/nvmrc.gotxt"},
+				{Path: ".github/workflows/node-ci.yml", Template: "templates/style/node/node-ci.yml.gotxt"},
+				{Path: ".github/workflows/npm-publish.yml", Template: "templates/style/node/npm-publish.yml.gotxt"},
+				{Path: ".gitignore", Template: "templates/style/node/gitignore.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "node ci", FileName: "node-ci.yml"},
+			},
+			Badges: []badgeType{
+				badgeNPM,
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "installation", Body: templateNodeReadmeInstallation},
+			},
+			VersionFiles: []bumpVersionFile{
+				{
+					Filename: "package.json",
+					Search:   `"version": "{current_version}"`,
+					Replace:  `"version": "{new_version}"`,
+				},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "install dependencies", Command: "npm install"},
+				{Description: "run tests", Command: "npm test"},
+				{Description: "run linter", Command: "npm run lint"},
+				{Description: "format code", Command: "npm run format"},
+			},
+			EnvironmentCommands: []string{"node --version", "npm --version"},
+			ProgrammingLanguage: "JavaScript",
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "npm",
+					Interval:  "daily",
+					Label:     "npm",
+					Prefix:    "[npm] - upgrade node dependencies",
+					Groups: []dependabotGroup{
+						{Name: "eslint", Patterns: []string{"eslint", "@eslint/*", "typescript-eslint"}},
+					},
+				},
+			},
+			DynamicFiles: nodeDynamicFiles,
+			PullRequestChecks: []string{
+				"`npm test` passes",
+				"`npm run lint` and `npm run format:check` report no issues",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "node-version",
+					Label:       "Node.js Version",
+					Description: "Output of `node --version`",
+					Placeholder: "v24.11.0",
+					Required:    true,
+				},
+				{
+					Type:     "dropdown",
+					ID:       "os",
+					Label:    "Operating System",
+					Options:  []string{"Linux", "macOS", "Windows", "Other"},
+					Required: true,
+				},
+			},
+		},
+		projectStyleDocker: {
+			Title: "Docker",
+			Files: []styleFile{
+				{Path: ".dockerignore", Template: "templates/style/docker/dockerignore.gotxt"},
+				{Path: "compose.yaml", Template: "templates/style/docker/compose.yaml.gotxt"},
+				{Path: ".github/workflows/docker-publish.yml", Template: "templates/style/docker/docker-publish.yml.gotxt"},
+				{Path: ".gitignore", Template: "templates/style/docker/gitignore.gotxt"},
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "installation", Body: templateDockerReadmeInstallation},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "build image", Command: "docker compose build"},
+				{Description: "run container, rebuilds on changes", Command: "docker compose up --watch"},
+			},
+			EnvironmentCommands: []string{"docker --version"},
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "docker",
+					Interval:  "weekly",
+					Label:     "docker",
+					Prefix:    "[docker] - upgrade base images",
+				},
+			},
+			DynamicFiles: dockerDynamicFiles,
+			PullRequestChecks: []string{
+				"`docker compose build` succeeds",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "docker-version",
+					Label:       "Docker Version",
+					Description: "Output of `docker --version`, if you run the image",
+					Placeholder: "Docker version 28.5.1, build e180ab8",
+				},
+			},
+		},
+		projectStyleAction: {
+			Title: "GitHub Action",
+			Files: []styleFile{
+				{Path: "action.yml", Template: "templates/style/action/action.yml.gotxt"},
+				{Path: ".github/workflows/action-test.yml", Template: "templates/style/action/action-test.yml.gotxt"},
+				{Path: ".github/workflows/action-release.yml", Template: "templates/style/action/action-release.yml.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "action test", FileName: "action-test.yml"},
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "usage", Body: templateActionReadmeUsage},
+			},
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "github-actions",
+					Interval:  "weekly",
+					Label:     "github-actions",
+					Prefix:    "[gha] - upgrade github action dependencies",
+				},
+			},
+			DynamicFiles: actionDynamicFiles,
+			PullRequestChecks: []string{
+				"`action test` workflow passes",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "action-version",
+					Label:       "Action Version",
+					Description: "Version or commit of the action in your workflow",
+					Placeholder: "v1",
+					Required:    true,
+				},
+				{
+					Type:        "input",
+					ID:          "runner",
+					Label:       "Runner",
+					Description: "Runner image of the failing job",
+					Placeholder: "ubuntu-24.04",
+				},
+			},
+		},
+		projectStyleTerraform: {
+			Title: "Terraform",
+			Files: []styleFile{
+				{Path: "main.tf", Template: "templates/style/terraform/main.tf.gotxt"},
+				{Path: "variables.tf", Template: "templates/style/terraform/variables.tf.gotxt"},
+				{Path: "outputs.tf", Template: "templates/style/terraform/outputs.tf.gotxt"},
+				{Path: "versions.tf", Template: "templates/style/terraform/versions.tf.gotxt"},
+				{Path: "examples/basic/main.tf", Template: "templates/style/terraform/examples/main.tf.gotxt"},
+				{Path: "examples/basic/versions.tf", Template: "templates/style/terraform/examples/versions.tf.gotxt"},
+				{Path: ".tflint.hcl", Template: "templates/style/terraform/tflint.hcl.gotxt"},
+				{Path: ".terraform-docs.yml", Template: "templates/style/terraform/terraform-docs.yml.gotxt"},
+				{Path: ".github/workflows/terraform-ci.yml", Template: "templates/style/terraform/terraform-ci.yml.gotxt"},
+				{Path: ".gitignore", Template: "templates/style/terraform/gitignore.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "terraform ci", FileName: "terraform-ci.yml"},
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "usage", Body: templateTerraformReadmeUsage},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "format code", Command: "terraform fmt -recursive"},
+				{Description: "validate module", Command: "terraform init -backend=false && terraform validate"},
+				{Description: "run linter", Command: `tflint --init && tflint --recursive --config "$(pwd)/.tflint.hcl"`},
+				{Description: "update README inputs and outputs", Command: "terraform-docs ."},
+			},
+			EnvironmentCommands: []string{"terraform version"},
+			ProgrammingLanguage: "HCL",
+			Dependabot: []dependabotUpdate{
+				{
+					Ecosystem: "terraform",
+					Interval:  "weekly",
+					Label:     "terraform",
+					Prefix:    "[terraform] - upgrade terraform providers and modules",
+				},
+			},
+			PreCommit: []preCommitRepo{
+				{
+					Repo: "https://github.com/antonbabenko/pre-commit-terraform",
+					Rev:  "v1.96.1",
+					Hooks: []preCommitHook{
+						{ID: "terraform_fmt"},
+						{ID: "terraform_validate"},
+						{ID: "terraform_docs", Args: []string{"--args=--config=.terraform-docs.yml"}},
+					},
+				},
+			},
+			PullRequestChecks: []string{
+				"`terraform fmt -check -recursive` and `terraform validate` pass",
+				"`tflint --recursive` reports no issues",
+				"`terraform-docs .` is run and README is up to date",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "terraform-version",
+					Label:       "Terraform Version",
+					Description: "Output of `terraform version`",
+					Placeholder: "Terraform v1.13.4 on linux_amd64",
+					Required:    true,
+				},
+				{
+					Type:        "input",
+					ID:          "provider-version",
+					Label:       "Provider Version",
+					Description: "Provider versions from `.terraform.lock.hcl` of your configuration",
+					Placeholder: "5.100.0",
+				},
+			},
+		},
+		projectStyleHelm: {
+			Title: "Helm",
+			Files: []styleFile{
+				{Path: "ct.yaml", Template: "templates/style/helm/ct.yaml.gotxt"},
+				{Path: ".github/workflows/helm-lint.yml", Template: "templates/style/helm/helm-lint.yml.gotxt"},
+				{Path: ".github/workflows/helm-release.yml", Template: "templates/style/helm/helm-release.yml.gotxt"},
+			},
+			Workflows: []styleWorkflow{
+				{Name: "helm lint", FileName: "helm-lint.yml"},
+			},
+			ReadmeSections: []readmeSection{
+				{Name: "installation", Body: templateHelmReadmeInstallation},
+			},
+			DevelopmentCommands: []developmentCommand{
+				{Description: "lint charts", Command: "ct lint --config ct.yaml --all"},
+				{Description: "render chart templates", Command: "helm template charts/*/"},
+			},
+			EnvironmentCommands: []string{"helm version", "kubectl version --client"},
+			DynamicFiles:        helmDynamicFiles,
+			PullRequestChecks: []string{
+				"`ct lint --config ct.yaml --all` passes",
+				"`values.schema.json` is updated for new values",
+			},
+			IssueFields: []issueFormField{
+				{
+					Type:        "input",
+					ID:          "chart-version",
+					Label:       "Chart Version",
+					Description: "Output of `helm list` for the release",
+					Placeholder: "0.1.0",
+					Required:    true,
+				},
+				{
+					Type:        "input",
+					ID:          "kubernetes-version",
+					Label:       "Kubernetes Version",
+					Description: "Server version from `kubectl version`",
+					Placeholder: "v1.34.1",
+				},
+			},
+		},
+	}
+}
+
+// files returns static and dynamic files of the style.
+func (psd projectStyleDefinition) files(vars *readmeVariables) []styleFile {
+	if psd.DynamicFiles == nil {
+		return psd.Files
+	}
+
+	return slices.Concat(psd.Files, psd.DynamicFiles(vars))
+}
+
+func (k *cmd) generateTemplateFiles(targetFolder string, fsys embed.FS, files []styleFile, vars any) error {
+	for _, file := range files {
+		tmpl, err := fsys.ReadFile(file.Template)
+		if err != nil {
+			return fmt.Errorf("could not read %s template, %w", file.Path, err)
+		}
+
+		filePath := strings.Join(
+			[]string{targetFolder, file.Path},
+			string(os.PathSeparator),
+		)
+
+		if err = k.GenerateTextFromTemplate(filePath, vars, string(tmpl)); err != nil {
+			return fmt.Errorf("could not generate %s file, %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// terraformVariables holds terraform style values used by module files,
+// terraform-docs config and README usage.
+type terraformVariables struct {
+	Provider        string
+	Name            string
+	ModuleIdent     string
+	RequiredVersion string
+	DocsFile        string
+	Root            string
+}
+
+const (
+	projectStyleTerraform = projectStyle("terraform")
+
+	terraformRequiredVersion = ">= 1.9"
+)
+
+// reTerraformModule matches registry naming convention, provider is a single
+// word and name can contain hyphens.
+var reTerraformModule = regexp.MustCompile(`^terraform-([a-z0-9]+)-([a-z0-9][a-z0-9-]*)$`)
+
+// sentinel errors.
+var ErrInvalidTerraformModuleName = errors.New("invalid terraform module repository name")
+
+func newTerraformVariables(repository, root string) (terraformVariables, error) {
+	matches := reTerraformModule.FindStringSubmatch(repository)
+	if matches == nil {
+		return terraformVariables{}, fmt.Errorf(
+			"%w `%s`, use terraform-<PROVIDER>-<NAME>, ex: terraform-aws-vpc",
+			ErrInvalidTerraformModuleName,
+			repository,
+		)
+	}
+
+	docsFile := fnReadme
+	if root != "" {
+		docsFile = strings.Repeat("../", strings.Count(root, "/")+1) + fnReadme
+	}
+
+	return terraformVariables{
+		Provider:        matches[1],
+		Name:            matches[2],
+		ModuleIdent:     strings.ReplaceAll(matches[2], "-", "_"),
+		RequiredVersion: terraformRequiredVersion,
+		DocsFile:        docsFile,
+		Root:            root,
+	}, nil
+}
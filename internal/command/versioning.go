@@ -0,0 +1,182 @@
+package command
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+//go:embed templates/versioning
+var templateVersioning embed.FS
+
+type (
+	versioningScheme  string
+	versioningSchemes map[versioningScheme]string
+
+	// versioningDefinition describes how a scheme keeps the version. Schemes
+	// using bumpversion rewrite version in files, others rely on git tags and
+	// releases so README gets a dynamic version badge. StyleAtRoot requires
+	// RequiresStyle at repository root where the release tool runs.
+	versioningDefinition struct {
+		BumpVersion   bool
+		DynamicBadge  bool
+		VersionFormat *regexp.Regexp
+		Files         []styleFile
+		RequiresStyle projectStyle
+		StyleAtRoot   bool
+	}
+
+	versioningVariables struct {
+		*readmeVariables
+		ReleaseType string
+		ExtraFiles  []string
+	}
+)
+
+func (vs versioningScheme) String() string {
+	return string(vs)
+}
+
+const (
+	versioningSemver          = versioningScheme("semver")
+	versioningSemverPre       = versioningScheme("semver-pre")
+	versioningCalver          = versioningScheme("calver")
+	versioningReleasePlease   = versioningScheme("release-please")
+	versioningSemanticRelease = versioningScheme("semantic-release")
+	versioningChangesets      = versioningScheme("changesets")
+	versioningGoreleaser      = versioningScheme("goreleaser")
+)
+
+// prereleaseLabels are pre_l values of semver-pre bumpversion config, the
+// optional final value is not written in versions.
+var prereleaseLabels = []string{"alpha", "beta", "rc"}
+
+var (
+	reSemanticVersion = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	// reSemanticVersionPrerelease follows parse rule of semver-pre bumpversion
+	// config, pre-release is one of prereleaseLabels and a number.
+	reSemanticVersionPrerelease = regexp.MustCompile(
+		`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+			`(-(` + strings.Join(prereleaseLabels, "|") + `)\.(0|[1-9]\d*))?` +
+			`(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`,
+	)
+	reCalendarVersion = regexp.MustCompile(`^\d{4}\.(1[0-2]|[1-9])\.\d+$`)
+)
+
+// sentinel errors.
+var (
+	ErrInvalidVersioning       = errors.New("invalid versioning option")
+	ErrVersioningRequiresStyle = errors.New("versioning requires project style")
+)
+
+func availableVersioningSchemes() versioningSchemes {
+	prerelease := "{" + strings.Join(prereleaseLabels, "|") + "}.N"
+
+	return versioningSchemes{
+		versioningSemver:          "bump-my-version with MAJOR.MINOR.PATCH",
+		versioningSemverPre:       "bump-my-version with MAJOR.MINOR.PATCH-" + prerelease + "+BUILD",
+		versioningCalver:          "bump-my-version with YYYY.MM.PATCH",
+		versioningReleasePlease:   "release-please manifest, config and workflow",
+		versioningSemanticRelease: "semantic-release config and workflow",
+		versioningChangesets:      "changesets config and workflow",
+		versioningGoreleaser:      "goreleaser config and tag triggered workflow",
+	}
+}
+
+func versioningDefinitions() map[versioningScheme]versioningDefinition {
+	return map[versioningScheme]versioningDefinition{
+		versioningSemver: {
+			BumpVersion:   true,
+			VersionFormat: reSemanticVersion,
+		},
+		versioningSemverPre: {
+			BumpVersion:   true,
+			DynamicBadge:  true,
+			VersionFormat: reSemanticVersionPrerelease,
+		},
+		versioningCalver: {
+			BumpVersion:   true,
+			VersionFormat: reCalendarVersion,
+		},
+		versioningReleasePlease: {
+			DynamicBadge:  true,
+			VersionFormat: reSemanticVersion,
+			Files: []styleFile{
+				{
+					Path:     "release-please-config.json",
+					Template: "templates/versioning/release-please/release-please-config.json.gotxt",
+				},
+				{
+					Path:     ".release-please-manifest.json",
+					Template: "templates/versioning/release-please/release-please-manifest.json.gotxt",
+				},
+				{
+					Path:     ".github/workflows/release-please.yml",
+					Template: "templates/versioning/release-please/release-please.yml.gotxt",
+				},
+			},
+		},
+		versioningSemanticRelease: {
+			DynamicBadge:  true,
+			VersionFormat: reSemanticVersion,
+			RequiresStyle: projectStyleNode,
+			StyleAtRoot:   true,
+			Files: []styleFile{
+				{Path: ".releaserc.json", Template: "templates/versioning/semantic-release/releaserc.json.gotxt"},
+				{Path: ".github/workflows/release.yml", Template: "templates/versioning/semantic-release/release.yml.gotxt"},
+			},
+		},
+		versioningChangesets: {
+			DynamicBadge:  true,
+			VersionFormat: reSemanticVersion,
+			RequiresStyle: projectStyleNode,
+			StyleAtRoot:   true,
+			Files: []styleFile{
+				{Path: ".changeset/config.json", Template: "templates/versioning/changesets/config.json.gotxt"},
+				{Path: ".changeset/README.md", Template: "templates/versioning/changesets/README.md.gotxt"},
+				{Path: ".github/workflows/release.yml", Template: "templates/versioning/changesets/release.yml.gotxt"},
+			},
+		},
+		versioningGoreleaser: {
+			DynamicBadge:  true,
+			VersionFormat: reSemanticVersion,
+			RequiresStyle: projectStyleGo,
+		},
+	}
+}
+
+// defaultVersion returns the version used when --initial-version is not set.
+func (vs versioningScheme) defaultVersion(now time.Time) string {
+	if vs == versioningCalver {
+		return fmt.Sprintf("%d.%d.0", now.Year(), now.Month())
+	}
+
+	return defaultInitialVersion
+}
+
+// usesConventionalCommits tells whether release tool of versioning scheme
+// derives versions from commit messages.
+func (vs versioningScheme) usesConventionalCommits() bool {
+	return vs == versioningReleasePlease || vs == versioningSemanticRelease
+}
+
+// releaseType maps project style to release-please release type.
+func releaseType(ps projectStyle) string {
+	switch ps {
+	case projectStyleGo:
+		return "go"
+	case projectStylePython:
+		return "python"
+	case projectStyleRust:
+		return "rust"
+	case projectStyleNode:
+		return "node"
+	case projectStyleTerraform:
+		return "terraform-module"
+	default:
+		return "simple"
+	}
+}